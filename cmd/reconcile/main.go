@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/infrastructure/config"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/postgres"
+	"e-wallet/internal/usecase"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Reconciles every wallet balance against its transactions, prints the run summary as JSON
+// and exits with status 2 when discrepancies were found, so it can be scheduled (e.g. at month-end)
+func main() {
+	cfg := config.MustLoad("configs/config.yaml")
+
+	if err := logger.Init(cfg.Log); err != nil {
+		fmt.Printf("Failed to init logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	db, err := database.NewPostgresDB(cfg.Database)
+	if err != nil {
+		logger.Error.Printf("Failed to connect to database: %v", err)
+		fmt.Printf("Failed to connect to database: %v\n", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}()
+
+	if err := database.RunMigrations(db); err != nil {
+		logger.Error.Printf("Failed to run migrations: %v", err)
+		fmt.Printf("Failed to run migrations: %v\n", err)
+		os.Exit(1)
+	}
+
+	reconciliation := usecase.NewReconciliationUseCase(db, postgres.NewReconciliationRepository(db))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	resp, err := reconciliation.Execute(ctx, &request.RunReconciliationRequest{})
+	if err != nil {
+		logger.Error.Printf("Reconciliation failed: %v", err)
+		fmt.Printf("Reconciliation failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	output, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		fmt.Printf("Failed to encode report: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(output))
+
+	if !resp.Balanced {
+		os.Exit(2)
+	}
+}
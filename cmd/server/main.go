@@ -59,6 +59,13 @@ func main() {
 	}()
 	fmt.Println("Application container initialized successfully")
 
+	// Release expired holds, deliver webhooks and settle ended business days in the background
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	go app.HoldSweeper.Run(workersCtx)
+	go app.WebhookDispatcher.Run(workersCtx)
+	go app.SettlementJob.Run(workersCtx)
+
 	fmt.Println("Initializing server...")
 	server := &http.Server{
 		Addr:         fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port),
@@ -87,6 +94,7 @@ func main() {
 	<-quit
 
 	logger.Info.Println("Shutting down server...")
+	stopWorkers()
 
 	// Graceful shutdown with 10 second timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
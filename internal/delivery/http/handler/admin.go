@@ -0,0 +1,448 @@
+package handler
+
+import (
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/usecase"
+	apperrors "e-wallet/pkg/errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminHandler struct {
+	identificationReviewUseCase  *usecase.IdentificationReviewUseCase
+	identificationHistoryUseCase *usecase.IdentificationHistoryUseCase
+	walletStatusUseCase          *usecase.WalletStatusUseCase
+	walletStatusHistoryUseCase   *usecase.WalletStatusHistoryUseCase
+	ledgerTrialBalanceUseCase    *usecase.LedgerTrialBalanceUseCase
+	reconciliationUseCase        *usecase.ReconciliationUseCase
+	floatTopUpUseCase            *usecase.FloatTopUpUseCase
+	feeScheduleCreateUseCase     *usecase.FeeScheduleCreateUseCase
+	feeSchedulesUseCase          *usecase.FeeSchedulesUseCase
+	settlementRunUseCase         *usecase.SettlementRunUseCase
+}
+
+func NewAdminHandler(
+	identificationReviewUseCase *usecase.IdentificationReviewUseCase,
+	identificationHistoryUseCase *usecase.IdentificationHistoryUseCase,
+	walletStatusUseCase *usecase.WalletStatusUseCase,
+	walletStatusHistoryUseCase *usecase.WalletStatusHistoryUseCase,
+	ledgerTrialBalanceUseCase *usecase.LedgerTrialBalanceUseCase,
+	reconciliationUseCase *usecase.ReconciliationUseCase,
+	floatTopUpUseCase *usecase.FloatTopUpUseCase,
+	feeScheduleCreateUseCase *usecase.FeeScheduleCreateUseCase,
+	feeSchedulesUseCase *usecase.FeeSchedulesUseCase,
+	settlementRunUseCase *usecase.SettlementRunUseCase,
+) *AdminHandler {
+	return &AdminHandler{
+		identificationReviewUseCase:  identificationReviewUseCase,
+		identificationHistoryUseCase: identificationHistoryUseCase,
+		walletStatusUseCase:          walletStatusUseCase,
+		walletStatusHistoryUseCase:   walletStatusHistoryUseCase,
+		ledgerTrialBalanceUseCase:    ledgerTrialBalanceUseCase,
+		reconciliationUseCase:        reconciliationUseCase,
+		floatTopUpUseCase:            floatTopUpUseCase,
+		feeScheduleCreateUseCase:     feeScheduleCreateUseCase,
+		feeSchedulesUseCase:          feeSchedulesUseCase,
+		settlementRunUseCase:         settlementRunUseCase,
+	}
+}
+
+// ApproveIdentification godoc
+// @Summary Approve wallet identification
+// @Description Approves the pending KYC submission of a wallet and promotes it to wallet_type, identified (max balance 100,000 TJS) by default. This is the only way to reach a tier other than unidentified. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.ReviewIdentificationRequest true "Review identification request"
+// @Success 200 {object} response.ReviewIdentificationResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/wallet/identification/approve [post]
+func (h *AdminHandler) ApproveIdentification(c *gin.Context) {
+	h.reviewIdentification(c, true)
+}
+
+// RejectIdentification godoc
+// @Summary Reject wallet identification
+// @Description Rejects the pending KYC submission of a wallet; reason is required. The partner may submit again. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.ReviewIdentificationRequest true "Review identification request"
+// @Success 200 {object} response.ReviewIdentificationResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/wallet/identification/reject [post]
+func (h *AdminHandler) RejectIdentification(c *gin.Context) {
+	h.reviewIdentification(c, false)
+}
+
+func (h *AdminHandler) reviewIdentification(c *gin.Context, approve bool) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.ReviewIdentification]: Admin with IP %s requested identification review (approve: %t, request ID: %s)", ip, approve, c.GetString("request_id"))
+
+	var req request.ReviewIdentificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.ReviewIdentification]: Failed to bind request: %v", err)
+		return
+	}
+	req.Approve = approve
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.identificationReviewUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[ReviewIdentification]: Admin with IP %s successfully reviewed identification (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetIdentificationHistory godoc
+// @Summary Get wallet identification history
+// @Description Returns the audit trail of a wallet's identification status changes, oldest first. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.IdentificationHistoryRequest true "Identification history request"
+// @Success 200 {object} response.IdentificationHistoryResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/wallet/identification/history [post]
+func (h *AdminHandler) GetIdentificationHistory(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetIdentificationHistory]: Admin with IP %s requested identification history (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.IdentificationHistoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.GetIdentificationHistory]: Failed to bind request: %v", err)
+		return
+	}
+
+	resp, err := h.identificationHistoryUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetIdentificationHistory]: Admin with IP %s successfully retrieved identification history (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ChangeWalletStatus godoc
+// @Summary Change wallet status
+// @Description Moves a wallet to active, frozen (credits only), blocked or closed (requires zero balance, terminal). A reason is required and recorded. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.ChangeWalletStatusRequest true "Change wallet status request"
+// @Success 200 {object} response.ChangeWalletStatusResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/wallet/status [post]
+func (h *AdminHandler) ChangeWalletStatus(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.ChangeWalletStatus]: Admin with IP %s requested wallet status change (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.ChangeWalletStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.ChangeWalletStatus]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.walletStatusUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[ChangeWalletStatus]: Admin with IP %s successfully changed wallet status (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetWalletStatusHistory godoc
+// @Summary Get wallet status history
+// @Description Returns the audit trail of a wallet's status changes with reasons, oldest first. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.WalletStatusHistoryRequest true "Wallet status history request"
+// @Success 200 {object} response.WalletStatusHistoryResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/wallet/status/history [post]
+func (h *AdminHandler) GetWalletStatusHistory(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetWalletStatusHistory]: Admin with IP %s requested wallet status history (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.WalletStatusHistoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.GetWalletStatusHistory]: Failed to bind request: %v", err)
+		return
+	}
+
+	resp, err := h.walletStatusHistoryUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetWalletStatusHistory]: Admin with IP %s successfully retrieved wallet status history (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetLedgerTrialBalance godoc
+// @Summary Get ledger trial balance
+// @Description Sums ledger account balances per account type. The total is zero and no account drifted from its postings when no money was created or destroyed. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Success 200 {object} response.LedgerTrialBalanceResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/ledger/trial-balance [post]
+func (h *AdminHandler) GetLedgerTrialBalance(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetLedgerTrialBalance]: Admin with IP %s requested ledger trial balance (request ID: %s)", ip, c.GetString("request_id"))
+
+	resp, err := h.ledgerTrialBalanceUseCase.Execute(c.Request.Context())
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetLedgerTrialBalance]: Admin with IP %s successfully retrieved ledger trial balance (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// RunReconciliation godoc
+// @Summary Run balance reconciliation
+// @Description Recomputes every wallet balance from its transactions (including opening balances) and compares it with the stored balance. The run and its discrepancies are stored. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Success 200 {object} response.ReconciliationResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/reconciliation/run [post]
+func (h *AdminHandler) RunReconciliation(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.RunReconciliation]: Admin with IP %s requested balance reconciliation (request ID: %s)", ip, c.GetString("request_id"))
+
+	req := request.RunReconciliationRequest{ClientID: c.GetInt64("client_id")}
+
+	resp, err := h.reconciliationUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[RunReconciliation]: Admin with IP %s successfully ran balance reconciliation (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// TopUpFloat godoc
+// @Summary Top up a partner float
+// @Description Credits a partner's float (dirams) once its settlement transfer has arrived. Each bank reference is applied at most once. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.TopUpFloatRequest true "Top up float request"
+// @Success 200 {object} response.TopUpFloatResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/float/top-up [post]
+func (h *AdminHandler) TopUpFloat(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.TopUpFloat]: Admin with IP %s requested float top-up (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.TopUpFloatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.TopUpFloat]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.floatTopUpUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[TopUpFloat]: Admin with IP %s successfully topped up the float of %s (request_id=%s)", ip, resp.UserID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateFeeSchedule godoc
+// @Summary Add a fee schedule version
+// @Description Adds a version of a partner's fee schedule, or of the default schedule when user_id is empty. Rules price deposits, withdrawals and transfers per amount band and wallet type with a fixed part, a percentage (basis points) and min/max caps. A version takes effect at effective_from (default now, never in the past) and replaces the previous one. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.CreateFeeScheduleRequest true "Create fee schedule request"
+// @Success 200 {object} response.FeeScheduleResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/fees/schedule [post]
+func (h *AdminHandler) CreateFeeSchedule(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.CreateFeeSchedule]: Admin with IP %s requested fee schedule creation (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.CreateFeeScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.CreateFeeSchedule]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.feeScheduleCreateUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[CreateFeeSchedule]: Admin with IP %s successfully created fee schedule %d (request_id=%s)", ip, resp.Schedule.ID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetFeeSchedules godoc
+// @Summary List fee schedule versions
+// @Description Returns every version of a partner's fee schedule, or of the default schedule when user_id is empty, newest first. Fee transactions reference the rule that priced them. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.ListFeeSchedulesRequest true "List fee schedules request"
+// @Success 200 {object} response.FeeSchedulesResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/fees/schedules [post]
+func (h *AdminHandler) GetFeeSchedules(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetFeeSchedules]: Admin with IP %s requested fee schedules (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.ListFeeSchedulesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.GetFeeSchedules]: Failed to bind request: %v", err)
+		return
+	}
+
+	resp, err := h.feeSchedulesUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetFeeSchedules]: Admin with IP %s successfully retrieved %d fee schedules (request_id=%s)", ip, len(resp.Schedules), c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// RunSettlement godoc
+// @Summary Settle a business day
+// @Description Generates the daily settlement of every partner for an ended business day (YYYY-MM-DD in the business time zone) and returns them. The settlement job does this automatically; existing settlements are kept, so running it again only returns them. Admin clients only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.RunSettlementRequest true "Run settlement request"
+// @Success 200 {object} response.RunSettlementResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /admin/settlement/run [post]
+func (h *AdminHandler) RunSettlement(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.RunSettlement]: Admin with IP %s requested a settlement run (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.RunSettlementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.RunSettlement]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.settlementRunUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[RunSettlement]: Admin with IP %s successfully settled %s (request_id=%s)", ip, req.BusinessDate, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/usecase"
+	apperrors "e-wallet/pkg/errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FloatHandler struct {
+	statementUseCase *usecase.FloatStatementUseCase
+}
+
+func NewFloatHandler(statementUseCase *usecase.FloatStatementUseCase) *FloatHandler {
+	return &FloatHandler{
+		statementUseCase: statementUseCase,
+	}
+}
+
+// GetFloatStatement godoc
+// @Summary Get float statement
+// @Description Returns the authenticated partner's float balance (dirams) and one page of its movements, newest first. Deposits draw the float down and fail with INSUFFICIENT_FLOAT when it runs dry
+// @Tags Float
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.FloatStatementRequest true "Float statement request"
+// @Success 200 {object} response.FloatStatementResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /float/statement [post]
+func (h *FloatHandler) GetFloatStatement(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetFloatStatement]: Client with IP %s requested float statement (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.FloatStatementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.GetFloatStatement]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.statementUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetFloatStatement]: Client with IP %s successfully retrieved float statement (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/usecase"
+	apperrors "e-wallet/pkg/errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HoldHandler struct {
+	authorizeUseCase *usecase.HoldAuthorizeUseCase
+	captureUseCase   *usecase.HoldCaptureUseCase
+	voidUseCase      *usecase.HoldVoidUseCase
+}
+
+func NewHoldHandler(
+	authorizeUseCase *usecase.HoldAuthorizeUseCase,
+	captureUseCase *usecase.HoldCaptureUseCase,
+	voidUseCase *usecase.HoldVoidUseCase,
+) *HoldHandler {
+	return &HoldHandler{
+		authorizeUseCase: authorizeUseCase,
+		captureUseCase:   captureUseCase,
+		voidUseCase:      voidUseCase,
+	}
+}
+
+// AuthorizeHold godoc
+// @Summary Authorize a hold
+// @Description Reserves an amount (dirams) of the available balance until it is captured, voided or expires after the configured TTL
+// @Tags Hold
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param Idempotency-Key header string false "Unique key to safely retry the request"
+// @Param request body request.AuthorizeHoldRequest true "Authorize a hold request"
+// @Success 200 {object} response.HoldResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /hold/authorize [post]
+func (h *HoldHandler) AuthorizeHold(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.AuthorizeHold]: Client with IP %s requested hold authorization (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.AuthorizeHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.AuthorizeHold]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+	req.IdempotencyKey = c.GetHeader("Idempotency-Key")
+
+	resp, err := h.authorizeUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[AuthorizeHold]: Client with IP %s successfully completed hold authorization for hold %d (request_id=%s)", ip, resp.HoldID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// CaptureHold godoc
+// @Summary Capture a hold
+// @Description Takes all or part of a held amount (dirams, defaults to the full amount) from the wallet and releases the rest. A hold is captured at most once
+// @Tags Hold
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param Idempotency-Key header string false "Unique key to safely retry the request"
+// @Param request body request.CaptureHoldRequest true "Capture a hold request"
+// @Success 200 {object} response.HoldResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /hold/capture [post]
+func (h *HoldHandler) CaptureHold(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.CaptureHold]: Client with IP %s requested hold capture (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.CaptureHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.CaptureHold]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+	req.IdempotencyKey = c.GetHeader("Idempotency-Key")
+
+	resp, err := h.captureUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[CaptureHold]: Client with IP %s successfully completed hold capture for hold %d (request_id=%s)", ip, resp.HoldID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// VoidHold godoc
+// @Summary Void a hold
+// @Description Releases an active hold without taking any money
+// @Tags Hold
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.VoidHoldRequest true "Void a hold request"
+// @Success 200 {object} response.HoldResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /hold/void [post]
+func (h *HoldHandler) VoidHold(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.VoidHold]: Client with IP %s requested hold void (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.VoidHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.VoidHold]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.voidUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[VoidHold]: Client with IP %s successfully completed hold void for hold %d (request_id=%s)", ip, resp.HoldID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/usecase"
+	apperrors "e-wallet/pkg/errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SettlementHandler struct {
+	settlementsUseCase *usecase.SettlementsUseCase
+	exportUseCase      *usecase.SettlementExportUseCase
+}
+
+func NewSettlementHandler(settlementsUseCase *usecase.SettlementsUseCase, exportUseCase *usecase.SettlementExportUseCase) *SettlementHandler {
+	return &SettlementHandler{
+		settlementsUseCase: settlementsUseCase,
+		exportUseCase:      exportUseCase,
+	}
+}
+
+// GetSettlements godoc
+// @Summary Get daily settlements
+// @Description Returns the authenticated partner's daily settlements for business dates from..to (YYYY-MM-DD, at most 92 days), oldest first. net_amount is owed by the partner when positive; days that have not ended yet are missing
+// @Tags Settlement
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.ListSettlementsRequest true "Settlements request"
+// @Success 200 {object} response.SettlementsResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /settlement/report [post]
+func (h *SettlementHandler) GetSettlements(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetSettlements]: Client with IP %s requested settlements (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.ListSettlementsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.GetSettlements]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.settlementsUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetSettlements]: Client with IP %s successfully retrieved %d settlements (request_id=%s)", ip, len(resp.Settlements), c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ExportSettlements godoc
+// @Summary Download daily settlements as CSV
+// @Description Returns the same settlements as /settlement/report as a CSV file with one row per business day; amounts are in dirams
+// @Tags Settlement
+// @Accept json
+// @Produce text/csv
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.ListSettlementsRequest true "Settlements request"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /settlement/report/csv [post]
+func (h *SettlementHandler) ExportSettlements(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.ExportSettlements]: Client with IP %s requested settlements CSV (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.ListSettlementsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.ExportSettlements]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	export, err := h.exportUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[ExportSettlements]: Client with IP %s successfully exported %s (request_id=%s)", ip, export.FileName, c.GetString("request_id"))
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.FileName))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", export.Content)
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/usecase"
+	apperrors "e-wallet/pkg/errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TransactionHandler struct {
+	reverseUseCase *usecase.TransactionReverseUseCase
+	getUseCase     *usecase.TransactionGetUseCase
+	statusUseCase  *usecase.TransactionStatusUseCase
+}
+
+func NewTransactionHandler(
+	reverseUseCase *usecase.TransactionReverseUseCase,
+	getUseCase *usecase.TransactionGetUseCase,
+	statusUseCase *usecase.TransactionStatusUseCase,
+) *TransactionHandler {
+	return &TransactionHandler{
+		reverseUseCase: reverseUseCase,
+		getUseCase:     getUseCase,
+		statusUseCase:  statusUseCase,
+	}
+}
+
+// ReverseTransaction godoc
+// @Summary Reverse a transaction
+// @Description Reverses all or part of a deposit or withdrawal made by the calling client with a compensating transaction. Amount in dirams, defaults to what has not been reversed yet. A transaction may be reversed in several parts up to its amount. Its fee is refunded in proportion to the part reversed. Idempotency-Key is required; a retry with the same key returns the first response
+// @Tags Transaction
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param Idempotency-Key header string true "Unique key of the reversal; retries with the same key are not applied twice"
+// @Param request body request.ReverseTransactionRequest true "Reverse transaction request"
+// @Success 200 {object} response.ReverseTransactionResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /transaction/reverse [post]
+func (h *TransactionHandler) ReverseTransaction(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.ReverseTransaction]: Client with IP %s requested transaction reversal (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.ReverseTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.ReverseTransaction]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+	req.IdempotencyKey = c.GetHeader("Idempotency-Key")
+
+	resp, err := h.reverseUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[ReverseTransaction]: Client with IP %s successfully reversed transaction %d (request_id=%s)", ip, req.TransactionID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetTransaction godoc
+// @Summary Get a transaction
+// @Description Returns one transaction by our transaction_id or by the external_id the partner sent with the deposit, including its description and metadata. Only transactions the client made or that belong to its wallets are visible
+// @Tags Transaction
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.GetTransactionRequest true "Get transaction request"
+// @Success 200 {object} response.TransactionResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /transaction/get [post]
+func (h *TransactionHandler) GetTransaction(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetTransaction]: Client with IP %s requested transaction lookup (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.GetTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.GetTransaction]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.getUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetTransaction]: Client with IP %s successfully retrieved transaction %d (request_id=%s)", ip, resp.ID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetTransactionStatus godoc
+// @Summary Get transaction status
+// @Description Returns the status (pending, completed, failed, reversed), amount, wallet, timestamps and reversals of one transaction by transaction_id or external_id. Use it after a timeout: TRANSACTION_NOT_FOUND for an external_id means the operation did not happen and can be retried
+// @Tags Transaction
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.GetTransactionStatusRequest true "Transaction status request"
+// @Success 200 {object} response.TransactionStatusResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /transaction/status [post]
+func (h *TransactionHandler) GetTransactionStatus(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetTransactionStatus]: Client with IP %s requested transaction status (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.GetTransactionStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.GetTransactionStatus]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.statusUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetTransactionStatus]: Client with IP %s successfully retrieved status of transaction %d (request_id=%s)", ip, resp.TransactionID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
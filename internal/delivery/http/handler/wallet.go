@@ -12,28 +12,49 @@ import (
 
 type WalletHandler struct {
 	checkUseCase        *usecase.WalletCheckUseCase
+	createUseCase       *usecase.WalletCreateUseCase
 	depositUseCase      *usecase.WalletDepositUseCase
+	withdrawUseCase     *usecase.WalletWithdrawUseCase
+	transferUseCase     *usecase.WalletTransferUseCase
+	identifyUseCase     *usecase.WalletIdentifyUseCase
 	balanceUseCase      *usecase.WalletBalanceUseCase
 	monthlyStatsUseCase *usecase.WalletMonthlyStatsUseCase
+	transactionsUseCase *usecase.WalletTransactionsUseCase
+	tiersUseCase        *usecase.WalletTiersUseCase
+	limitsUseCase       *usecase.WalletLimitsUseCase
 }
 
 func NewWalletHandler(
 	checkUseCase *usecase.WalletCheckUseCase,
+	createUseCase *usecase.WalletCreateUseCase,
 	depositUseCase *usecase.WalletDepositUseCase,
+	withdrawUseCase *usecase.WalletWithdrawUseCase,
+	transferUseCase *usecase.WalletTransferUseCase,
+	identifyUseCase *usecase.WalletIdentifyUseCase,
 	balanceUseCase *usecase.WalletBalanceUseCase,
 	monthlyStatsUseCase *usecase.WalletMonthlyStatsUseCase,
+	transactionsUseCase *usecase.WalletTransactionsUseCase,
+	tiersUseCase *usecase.WalletTiersUseCase,
+	limitsUseCase *usecase.WalletLimitsUseCase,
 ) *WalletHandler {
 	return &WalletHandler{
 		checkUseCase:        checkUseCase,
+		createUseCase:       createUseCase,
 		depositUseCase:      depositUseCase,
+		withdrawUseCase:     withdrawUseCase,
+		transferUseCase:     transferUseCase,
+		identifyUseCase:     identifyUseCase,
 		balanceUseCase:      balanceUseCase,
 		monthlyStatsUseCase: monthlyStatsUseCase,
+		transactionsUseCase: transactionsUseCase,
+		tiersUseCase:        tiersUseCase,
+		limitsUseCase:       limitsUseCase,
 	}
 }
 
 // CheckWallet godoc
 // @Summary Check wallet existence
-// @Description Checks if a wallet account exists by account_id
+// @Description Checks if a wallet account owned by the calling client exists by account_id
 // @Tags Wallet
 // @Accept json
 // @Produce json
@@ -57,6 +78,7 @@ func (h *WalletHandler) CheckWallet(c *gin.Context) {
 		logger.Error.Printf("[handler.CheckWallet]: Failed to bind request: %v", err)
 		return
 	}
+	req.ClientID = c.GetInt64("client_id")
 
 	resp, err := h.checkUseCase.Execute(c.Request.Context(), &req)
 	if err != nil {
@@ -68,6 +90,44 @@ func (h *WalletHandler) CheckWallet(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// CreateWallet godoc
+// @Summary Create wallet
+// @Description Opens an empty unidentified wallet owned by the calling client. Any other wallet_type fails with IDENTIFICATION_REQUIRED, higher tiers are granted by approved identification; an existing account_id fails with ALREADY_EXISTS
+// @Tags Wallet
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.CreateWalletRequest true "Create wallet request"
+// @Success 200 {object} response.CreateWalletResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /wallet/create [post]
+func (h *WalletHandler) CreateWallet(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.CreateWallet]: Client with IP %s requested wallet creation (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.CreateWalletRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.CreateWallet]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.createUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[CreateWallet]: Client with IP %s successfully created wallet (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // Deposit godoc
 // @Summary Deposit to wallet
 // @Description Deposits money to a wallet account. Amount is in dirams (1 TJS = 100 dirams). Validates limits: 10,000 TJS for unidentified, 100,000 TJS for identified wallets.
@@ -76,6 +136,7 @@ func (h *WalletHandler) CheckWallet(c *gin.Context) {
 // @Produce json
 // @Param X-UserId header string true "User ID"
 // @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param Idempotency-Key header string false "Unique key to safely retry the request"
 // @Param request body request.DepositRequest true "Deposit request"
 // @Success 200 {object} response.DepositResponse
 // @Failure 400 {object} response.ErrorResponse
@@ -94,6 +155,8 @@ func (h *WalletHandler) Deposit(c *gin.Context) {
 		logger.Error.Printf("[handler.Deposit]: Failed to bind request: %v", err)
 		return
 	}
+	req.ClientID = c.GetInt64("client_id")
+	req.IdempotencyKey = c.GetHeader("Idempotency-Key")
 
 	resp, err := h.depositUseCase.Execute(c.Request.Context(), &req)
 	if err != nil {
@@ -105,6 +168,125 @@ func (h *WalletHandler) Deposit(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// Withdraw godoc
+// @Summary Withdraw from wallet
+// @Description Withdraws money from a wallet account. Amount is in dirams (1 TJS = 100 dirams). Fails with INSUFFICIENT_FUNDS if the balance is too low.
+// @Tags Wallet
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param Idempotency-Key header string false "Unique key to safely retry the request"
+// @Param request body request.WithdrawRequest true "Withdraw request"
+// @Success 200 {object} response.WithdrawResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /wallet/withdraw [post]
+func (h *WalletHandler) Withdraw(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.Withdraw]: Client with IP %s requested wallet withdrawal (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.WithdrawRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.Withdraw]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+	req.IdempotencyKey = c.GetHeader("Idempotency-Key")
+
+	resp, err := h.withdrawUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[Withdraw]: Client with IP %s successfully withdrew money (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Transfer godoc
+// @Summary Transfer between wallets
+// @Description Moves money from one wallet to another atomically. Amount is in dirams (1 TJS = 100 dirams). The destination wallet balance limit is enforced.
+// @Tags Wallet
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param Idempotency-Key header string false "Unique key to safely retry the request"
+// @Param request body request.TransferRequest true "Transfer request"
+// @Success 200 {object} response.TransferResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /wallet/transfer [post]
+func (h *WalletHandler) Transfer(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.Transfer]: Client with IP %s requested wallet transfer (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.TransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.Transfer]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+	req.IdempotencyKey = c.GetHeader("Idempotency-Key")
+
+	resp, err := h.transferUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[Transfer]: Client with IP %s successfully transferred money (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// IdentifyWallet godoc
+// @Summary Submit wallet identification
+// @Description Submits customer KYC data for an unidentified wallet and moves it to pending_identification. An admin approval promotes it to identified
+// @Tags Wallet
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.IdentifyWalletRequest true "Identify wallet request"
+// @Success 200 {object} response.IdentifyWalletResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /wallet/identify [post]
+func (h *WalletHandler) IdentifyWallet(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.IdentifyWallet]: Client with IP %s submitted wallet identification (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.IdentifyWalletRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.IdentifyWallet]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.identifyUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[IdentifyWallet]: Client with IP %s successfully submitted wallet identification (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // GetBalance godoc
 // @Summary Get wallet balance
 // @Description Returns current wallet balance in dirams (1 TJS = 100 dirams)
@@ -131,6 +313,7 @@ func (h *WalletHandler) GetBalance(c *gin.Context) {
 		logger.Error.Printf("[handler.GetBalance]: Failed to bind request: %v", err)
 		return
 	}
+	req.ClientID = c.GetInt64("client_id")
 
 	resp, err := h.balanceUseCase.Execute(c.Request.Context(), &req)
 	if err != nil {
@@ -143,8 +326,8 @@ func (h *WalletHandler) GetBalance(c *gin.Context) {
 }
 
 // GetMonthlyStats godoc
-// @Summary Get monthly statistics
-// @Description Returns total count and amount of deposits for the current month
+// @Summary Get wallet statistics
+// @Description Returns statistics for a month (YYYY-MM) or a from/to date range, defaulting to the current month. total_count and total_amount cover deposits; by_type and the day or week breakdown cover all transaction types
 // @Tags Wallet
 // @Accept json
 // @Produce json
@@ -168,6 +351,7 @@ func (h *WalletHandler) GetMonthlyStats(c *gin.Context) {
 		logger.Error.Printf("[handler.GetMonthlyStats]: Failed to bind request: %v", err)
 		return
 	}
+	req.ClientID = c.GetInt64("client_id")
 
 	resp, err := h.monthlyStatsUseCase.Execute(c.Request.Context(), &req)
 	if err != nil {
@@ -178,3 +362,107 @@ func (h *WalletHandler) GetMonthlyStats(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// GetTransactions godoc
+// @Summary Get transaction history
+// @Description Returns a page of wallet transactions, newest first, with the balance after each operation. Filter by type, created_at range (to is exclusive) and amount range; pass next_cursor as cursor to get the next page
+// @Tags Wallet
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.GetTransactionsRequest true "Get transactions request"
+// @Success 200 {object} response.TransactionHistoryResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /wallet/transactions [post]
+func (h *WalletHandler) GetTransactions(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetTransactions]: Client with IP %s requested transaction history (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.GetTransactionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.GetTransactions]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.transactionsUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetTransactions]: Client with IP %s successfully retrieved transaction history (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetWalletTiers godoc
+// @Summary List wallet tiers
+// @Description Returns every wallet tier with its max balance and turnover limits in dirams, lowest max balance first. A turnover limit of 0 is not enforced
+// @Tags Wallet
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Success 200 {object} response.WalletTiersResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /wallet/tiers [post]
+func (h *WalletHandler) GetWalletTiers(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetWalletTiers]: Client with IP %s requested wallet tiers (request ID: %s)", ip, c.GetString("request_id"))
+
+	resp, err := h.tiersUseCase.Execute(c.Request.Context())
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetWalletTiers]: Client with IP %s successfully retrieved wallet tiers (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetLimits godoc
+// @Summary Get remaining wallet limits
+// @Description Returns the wallet type, max balance, the largest deposit accepted right now and the used and remaining daily and monthly incoming turnover. Amounts in dirams; remaining values are null for limits that are not enforced
+// @Tags Wallet
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.GetLimitsRequest true "Get limits request"
+// @Success 200 {object} response.GetLimitsResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /wallet/limits [post]
+func (h *WalletHandler) GetLimits(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetLimits]: Client with IP %s requested wallet limits (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.GetLimitsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.GetLimits]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.limitsUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetLimits]: Client with IP %s successfully retrieved wallet limits (request_id=%s)", ip, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
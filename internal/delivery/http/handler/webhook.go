@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/usecase"
+	apperrors "e-wallet/pkg/errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	subscribeUseCase     *usecase.WebhookSubscribeUseCase
+	unsubscribeUseCase   *usecase.WebhookUnsubscribeUseCase
+	subscriptionsUseCase *usecase.WebhookSubscriptionsUseCase
+	deliveriesUseCase    *usecase.WebhookDeliveriesUseCase
+	redeliverUseCase     *usecase.WebhookRedeliverUseCase
+}
+
+func NewWebhookHandler(
+	subscribeUseCase *usecase.WebhookSubscribeUseCase,
+	unsubscribeUseCase *usecase.WebhookUnsubscribeUseCase,
+	subscriptionsUseCase *usecase.WebhookSubscriptionsUseCase,
+	deliveriesUseCase *usecase.WebhookDeliveriesUseCase,
+	redeliverUseCase *usecase.WebhookRedeliverUseCase,
+) *WebhookHandler {
+	return &WebhookHandler{
+		subscribeUseCase:     subscribeUseCase,
+		unsubscribeUseCase:   unsubscribeUseCase,
+		subscriptionsUseCase: subscriptionsUseCase,
+		deliveriesUseCase:    deliveriesUseCase,
+		redeliverUseCase:     redeliverUseCase,
+	}
+}
+
+// Subscribe godoc
+// @Summary Subscribe to webhook events
+// @Description Registers an https endpoint on a public host for the given event types (deposit.completed, wallet.limit_reached, wallet.status_changed). An empty list subscribes to every event type. Deliveries are signed with the client's secret
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.CreateWebhookSubscriptionRequest true "Subscribe request"
+// @Success 200 {object} response.WebhookSubscriptionResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /webhook/subscribe [post]
+func (h *WebhookHandler) Subscribe(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.Subscribe]: Client with IP %s requested webhook subscription (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.CreateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.Subscribe]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.subscribeUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[Subscribe]: Client with IP %s successfully created webhook subscription %d (request_id=%s)", ip, resp.Subscription.ID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Unsubscribe godoc
+// @Summary Remove a webhook subscription
+// @Description Deactivates a subscription of the authenticated client. Pending deliveries for it are dropped into the dead letter state
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.DeleteWebhookSubscriptionRequest true "Unsubscribe request"
+// @Success 200 {object} response.WebhookSubscriptionResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /webhook/unsubscribe [post]
+func (h *WebhookHandler) Unsubscribe(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.Unsubscribe]: Client with IP %s requested webhook unsubscription (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.DeleteWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.Unsubscribe]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.unsubscribeUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[Unsubscribe]: Client with IP %s successfully removed webhook subscription %d (request_id=%s)", ip, req.SubscriptionID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetSubscriptions godoc
+// @Summary List webhook subscriptions
+// @Description Returns every webhook subscription of the authenticated client, newest first
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Success 200 {object} response.WebhookSubscriptionsResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /webhook/subscriptions [post]
+func (h *WebhookHandler) GetSubscriptions(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetSubscriptions]: Client with IP %s requested webhook subscriptions (request ID: %s)", ip, c.GetString("request_id"))
+
+	req := request.ListWebhookSubscriptionsRequest{ClientID: c.GetInt64("client_id")}
+
+	resp, err := h.subscriptionsUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetSubscriptions]: Client with IP %s successfully retrieved %d webhook subscriptions (request_id=%s)", ip, len(resp.Subscriptions), c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetDeliveries godoc
+// @Summary List webhook deliveries
+// @Description Returns the latest webhook deliveries of the authenticated client, newest first, optionally filtered by status (pending, delivered, dead)
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.ListWebhookDeliveriesRequest true "List deliveries request"
+// @Success 200 {object} response.WebhookDeliveriesResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /webhook/deliveries [post]
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.GetDeliveries]: Client with IP %s requested webhook deliveries (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.ListWebhookDeliveriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.GetDeliveries]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.deliveriesUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[GetDeliveries]: Client with IP %s successfully retrieved %d webhook deliveries (request_id=%s)", ip, len(resp.Deliveries), c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Redeliver godoc
+// @Summary Redeliver a webhook
+// @Description Queues a delivered or dead delivery for another attempt with a fresh retry budget. The payload and event ID are unchanged
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Param X-UserId header string true "User ID"
+// @Param X-Digest header string true "HMAC-SHA1 digest"
+// @Param request body request.RedeliverWebhookRequest true "Redeliver request"
+// @Success 200 {object} response.RedeliverWebhookResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Security HMACAuth
+// @Security HMACDigest
+// @Router /webhook/redeliver [post]
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[handler.Redeliver]: Client with IP %s requested webhook redelivery (request ID: %s)", ip, c.GetString("request_id"))
+
+	var req request.RedeliverWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, apperrors.ErrInvalidRequest)
+		logger.Error.Printf("[handler.Redeliver]: Failed to bind request: %v", err)
+		return
+	}
+	req.ClientID = c.GetInt64("client_id")
+
+	resp, err := h.redeliverUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	logger.Info.Printf("[Redeliver]: Client with IP %s successfully queued webhook delivery %d (request_id=%s)", ip, req.DeliveryID, c.GetString("request_id"))
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"e-wallet/internal/delivery/http/handler"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminOnly rejects requests from API clients without admin privileges. It must run after HMACAuth.
+func AdminOnly() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !c.GetBool("is_admin") {
+			logger.Warning.Printf("[middleware.AdminOnly]: Non-admin client %s attempted to access %s", c.GetString("user_id"), c.Request.URL.Path)
+			handler.HandleError(c, apperrors.ErrForbidden)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -13,8 +13,9 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// HMACAuth validates HMAC authentication with caching support
-func HMACAuth(clientRepo repository.ClientRepository, clientCacheUseCase *usecase.ClientCacheUseCase, algorithm crypto.HMACAlgorithm) gin.HandlerFunc {
+// HMACAuth validates HMAC authentication with caching support.
+// Clients with replay protection enabled also sign the method, path, X-Timestamp and X-Nonce.
+func HMACAuth(clientRepo repository.ClientRepository, clientCacheUseCase *usecase.ClientCacheUseCase, replayGuard *usecase.ReplayGuardUseCase, algorithm crypto.HMACAlgorithm) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userID := c.GetHeader("X-UserId")
 		digest := c.GetHeader("X-Digest")
@@ -56,15 +57,54 @@ func HMACAuth(clientRepo repository.ClientRepository, clientCacheUseCase *usecas
 			return
 		}
 
-		if !crypto.ValidateHMAC(algorithm, client.SecretKey, string(body), digest) {
+		signedData := string(body)
+		timestamp := c.GetHeader("X-Timestamp")
+		nonce := c.GetHeader("X-Nonce")
+
+		if client.ReplayProtection {
+			if timestamp == "" || nonce == "" {
+				logger.Warning.Printf("[middleware.HMACAuth]: Missing replay protection headers for user: %s", userID)
+				handler.HandleError(c, apperrors.ErrMissingAuthData)
+				c.Abort()
+				return
+			}
+
+			if err := replayGuard.ValidateTimestamp(timestamp); err != nil {
+				logger.Warning.Printf("[middleware.HMACAuth]: Rejected timestamp '%s' for user: %s", timestamp, userID)
+				handler.HandleError(c, err)
+				c.Abort()
+				return
+			}
+
+			if err := replayGuard.ValidateNonce(nonce); err != nil {
+				handler.HandleError(c, err)
+				c.Abort()
+				return
+			}
+
+			signedData = crypto.BuildSigningString(c.Request.Method, c.Request.URL.Path, timestamp, nonce, string(body))
+		}
+
+		if !crypto.ValidateHMAC(algorithm, client.SecretKey, signedData, digest) {
 			logger.Warning.Printf("[middleware.HMACAuth]: Invalid HMAC signature for user: %s", userID)
 			handler.HandleError(c, apperrors.ErrInvalidSignature)
 			c.Abort()
 			return
 		}
 
+		// Nonces are recorded only after the signature is verified so they cannot be burned by forged requests
+		if client.ReplayProtection {
+			if err := replayGuard.RegisterNonce(c.Request.Context(), client.ID, nonce); err != nil {
+				logger.Warning.Printf("[middleware.HMACAuth]: Rejected nonce for user %s: %v", userID, err)
+				handler.HandleError(c, err)
+				c.Abort()
+				return
+			}
+		}
+
 		c.Set("client_id", client.ID)
 		c.Set("user_id", userID)
+		c.Set("is_admin", client.IsAdmin)
 
 		c.Next()
 	}
@@ -19,9 +19,16 @@ import (
 
 type RouterConfig struct {
 	WalletHandler       *handler.WalletHandler
+	TransactionHandler  *handler.TransactionHandler
+	HoldHandler         *handler.HoldHandler
+	WebhookHandler      *handler.WebhookHandler
+	FloatHandler        *handler.FloatHandler
+	SettlementHandler   *handler.SettlementHandler
+	AdminHandler        *handler.AdminHandler
 	ClientRepo          repository.ClientRepository
 	CacheRepo           repository.CacheRepository
 	ClientCacheUseCase  *usecase.ClientCacheUseCase
+	ReplayGuardUseCase  *usecase.ReplayGuardUseCase
 	HMACAlgorithm       crypto.HMACAlgorithm
 	GinMode             string
 	Environment         string
@@ -71,15 +78,82 @@ func NewRouter(cfg *RouterConfig) *gin.Engine {
 
 	// API v1 routes with HMAC authentication
 	v1 := router.Group("/api/v1")
-	v1.Use(middleware.HMACAuth(cfg.ClientRepo, cfg.ClientCacheUseCase, cfg.HMACAlgorithm))
+	v1.Use(middleware.HMACAuth(cfg.ClientRepo, cfg.ClientCacheUseCase, cfg.ReplayGuardUseCase, cfg.HMACAlgorithm))
 	{
 		// Wallet routes
 		wallet := v1.Group("/wallet")
 		{
 			wallet.POST("/check", cfg.WalletHandler.CheckWallet)
+			wallet.POST("/create", cfg.WalletHandler.CreateWallet)
 			wallet.POST("/deposit", cfg.WalletHandler.Deposit)
+			wallet.POST("/withdraw", cfg.WalletHandler.Withdraw)
+			wallet.POST("/transfer", cfg.WalletHandler.Transfer)
+			wallet.POST("/identify", cfg.WalletHandler.IdentifyWallet)
 			wallet.POST("/balance", cfg.WalletHandler.GetBalance)
 			wallet.POST("/monthly-stats", cfg.WalletHandler.GetMonthlyStats)
+			wallet.POST("/transactions", cfg.WalletHandler.GetTransactions)
+			wallet.POST("/tiers", cfg.WalletHandler.GetWalletTiers)
+			wallet.POST("/limits", cfg.WalletHandler.GetLimits)
+		}
+
+		// Transaction routes
+		transaction := v1.Group("/transaction")
+		{
+			transaction.POST("/reverse", cfg.TransactionHandler.ReverseTransaction)
+			transaction.POST("/get", cfg.TransactionHandler.GetTransaction)
+			transaction.POST("/status", cfg.TransactionHandler.GetTransactionStatus)
+		}
+
+		// Hold routes (two-phase payments)
+		hold := v1.Group("/hold")
+		{
+			hold.POST("/authorize", cfg.HoldHandler.AuthorizeHold)
+			hold.POST("/capture", cfg.HoldHandler.CaptureHold)
+			hold.POST("/void", cfg.HoldHandler.VoidHold)
+		}
+
+		// Webhook routes
+		webhook := v1.Group("/webhook")
+		{
+			webhook.POST("/subscribe", cfg.WebhookHandler.Subscribe)
+			webhook.POST("/unsubscribe", cfg.WebhookHandler.Unsubscribe)
+			webhook.POST("/subscriptions", cfg.WebhookHandler.GetSubscriptions)
+			webhook.POST("/deliveries", cfg.WebhookHandler.GetDeliveries)
+			webhook.POST("/redeliver", cfg.WebhookHandler.Redeliver)
+		}
+
+		// Partner float routes
+		float := v1.Group("/float")
+		{
+			float.POST("/statement", cfg.FloatHandler.GetFloatStatement)
+		}
+
+		// Partner settlement routes
+		settlement := v1.Group("/settlement")
+		{
+			settlement.POST("/report", cfg.SettlementHandler.GetSettlements)
+			settlement.POST("/report/csv", cfg.SettlementHandler.ExportSettlements)
+		}
+
+		// Admin routes
+		admin := v1.Group("/admin")
+		admin.Use(middleware.AdminOnly())
+		{
+			identification := admin.Group("/wallet/identification")
+			{
+				identification.POST("/approve", cfg.AdminHandler.ApproveIdentification)
+				identification.POST("/reject", cfg.AdminHandler.RejectIdentification)
+				identification.POST("/history", cfg.AdminHandler.GetIdentificationHistory)
+			}
+
+			admin.POST("/wallet/status", cfg.AdminHandler.ChangeWalletStatus)
+			admin.POST("/wallet/status/history", cfg.AdminHandler.GetWalletStatusHistory)
+			admin.POST("/ledger/trial-balance", cfg.AdminHandler.GetLedgerTrialBalance)
+			admin.POST("/reconciliation/run", cfg.AdminHandler.RunReconciliation)
+			admin.POST("/float/top-up", cfg.AdminHandler.TopUpFloat)
+			admin.POST("/fees/schedule", cfg.AdminHandler.CreateFeeSchedule)
+			admin.POST("/fees/schedules", cfg.AdminHandler.GetFeeSchedules)
+			admin.POST("/settlement/run", cfg.AdminHandler.RunSettlement)
 		}
 	}
 
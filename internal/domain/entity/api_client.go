@@ -3,12 +3,14 @@ package entity
 import "time"
 
 type APIClient struct {
-	ID        int64
-	UserID    string
-	SecretKey string
-	IsActive  bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID               int64
+	UserID           string
+	SecretKey        string
+	IsActive         bool
+	IsAdmin          bool
+	ReplayProtection bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 // Authenticate checks if the given digest matches the expected digest
@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"e-wallet/internal/domain/valueobject"
+	apperrors "e-wallet/pkg/errors"
+	"time"
+)
+
+// FeeOperation is an operation that can be charged a fee
+type FeeOperation string
+
+const (
+	FeeOperationDeposit    FeeOperation = "deposit"
+	FeeOperationWithdrawal FeeOperation = "withdrawal"
+	FeeOperationTransfer   FeeOperation = "transfer"
+)
+
+func (o FeeOperation) IsValid() bool {
+	switch o {
+	case FeeOperationDeposit, FeeOperationWithdrawal, FeeOperationTransfer:
+		return true
+	default:
+		return false
+	}
+}
+
+// maxPercentBps is 100% in basis points
+const maxPercentBps = 10000
+
+// FeeRule prices one operation within an amount band, optionally for one wallet type only.
+// The fee is FixedFee plus PercentBps of the amount (rounded half up), kept within MinFee and MaxFee.
+// All amounts are in dirams.
+type FeeRule struct {
+	ID         int64
+	Operation  FeeOperation
+	WalletType valueobject.WalletType // empty matches every wallet type
+	MinAmount  int64                  // band start, inclusive
+	MaxAmount  int64                  // band end, exclusive; 0 means no upper bound
+	FixedFee   int64
+	PercentBps int64 // basis points, 100 = 1%
+	MinFee     int64
+	MaxFee     int64 // 0 means no cap
+}
+
+// Matches reports whether the rule prices the operation on a wallet of the given type
+func (r *FeeRule) Matches(operation FeeOperation, walletType valueobject.WalletType, amount valueobject.Money) bool {
+	if r.Operation != operation {
+		return false
+	}
+	if r.WalletType != "" && r.WalletType != walletType {
+		return false
+	}
+	return amount.Amount() >= r.MinAmount && (r.MaxAmount == 0 || amount.Amount() < r.MaxAmount)
+}
+
+// Compute returns the fee for amount
+func (r *FeeRule) Compute(amount valueobject.Money) valueobject.Money {
+	fee := r.FixedFee + (amount.Amount()*r.PercentBps+maxPercentBps/2)/maxPercentBps
+	if fee < r.MinFee {
+		fee = r.MinFee
+	}
+	if r.MaxFee > 0 && fee > r.MaxFee {
+		fee = r.MaxFee
+	}
+
+	money, _ := valueobject.NewMoney(fee)
+	return money
+}
+
+func (r *FeeRule) validate() error {
+	if !r.Operation.IsValid() {
+		return apperrors.ErrInvalidFeeSchedule
+	}
+	if r.WalletType != "" {
+		if _, err := valueobject.NewWalletType(string(r.WalletType)); err != nil {
+			return err
+		}
+	}
+	if r.MinAmount < 0 || r.MaxAmount < 0 || (r.MaxAmount != 0 && r.MaxAmount <= r.MinAmount) {
+		return apperrors.ErrInvalidFeeSchedule
+	}
+	if r.FixedFee < 0 || r.PercentBps < 0 || r.PercentBps > maxPercentBps || r.MinFee < 0 || r.MaxFee < 0 {
+		return apperrors.ErrInvalidFeeSchedule
+	}
+	if r.MaxFee != 0 && r.MaxFee < r.MinFee {
+		return apperrors.ErrInvalidFeeSchedule
+	}
+	return nil
+}
+
+// overlaps reports whether both rules could price the same operation
+func (r *FeeRule) overlaps(other *FeeRule) bool {
+	if r.Operation != other.Operation || r.WalletType != other.WalletType {
+		return false
+	}
+	startsBeforeOtherEnds := other.MaxAmount == 0 || r.MinAmount < other.MaxAmount
+	endsAfterOtherStarts := r.MaxAmount == 0 || other.MinAmount < r.MaxAmount
+	return startsBeforeOtherEnds && endsAfterOtherStarts
+}
+
+// FeeSchedule is one version of the fees of a partner, or of the default fees when ClientID is nil.
+// Schedules are never changed: a new version with a later EffectiveFrom replaces the old one,
+// so every fee transaction can still be explained by the rule that priced it.
+type FeeSchedule struct {
+	ID            int64
+	ClientID      *int64 // nil for the default schedule of partners without their own
+	EffectiveFrom time.Time
+	CreatedBy     int64 // admin client that created the version
+	Rules         []*FeeRule
+	CreatedAt     time.Time
+}
+
+// NewFeeSchedule validates the rules; operations without a rule are free
+func NewFeeSchedule(clientID *int64, effectiveFrom time.Time, createdBy int64, rules []*FeeRule) (*FeeSchedule, error) {
+	for i, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return nil, err
+		}
+		for _, other := range rules[:i] {
+			if rule.overlaps(other) {
+				return nil, apperrors.ErrInvalidFeeSchedule
+			}
+		}
+	}
+
+	return &FeeSchedule{
+		ClientID:      clientID,
+		EffectiveFrom: effectiveFrom,
+		CreatedBy:     createdBy,
+		Rules:         rules,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// RuleFor returns the rule pricing the operation, preferring rules for the wallet's type
+// over rules for every type; nil means the operation is free
+func (s *FeeSchedule) RuleFor(operation FeeOperation, walletType valueobject.WalletType, amount valueobject.Money) *FeeRule {
+	var generic *FeeRule
+	for _, rule := range s.Rules {
+		if !rule.Matches(operation, walletType, amount) {
+			continue
+		}
+		if rule.WalletType != "" {
+			return rule
+		}
+		generic = rule
+	}
+	return generic
+}
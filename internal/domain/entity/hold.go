@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"e-wallet/internal/domain/valueobject"
+	apperrors "e-wallet/pkg/errors"
+	"time"
+)
+
+// HoldStatus is the state of a hold; only active holds reserve money
+type HoldStatus string
+
+const (
+	HoldStatusActive   HoldStatus = "active"
+	HoldStatusCaptured HoldStatus = "captured"
+	HoldStatusVoided   HoldStatus = "voided"
+	HoldStatusExpired  HoldStatus = "expired"
+)
+
+// Hold reserves money on a wallet until it is captured, voided or expires
+type Hold struct {
+	ID             int64
+	WalletID       int64
+	ClientID       int64 // API client that authorized the hold
+	Amount         valueobject.Money
+	CapturedAmount valueobject.Money
+	Status         HoldStatus
+	Reference      string // also the reference of the capture transaction
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewHold(walletID, clientID int64, amount valueobject.Money, reference string, ttl time.Duration) *Hold {
+	now := time.Now()
+	return &Hold{
+		WalletID:  walletID,
+		ClientID:  clientID,
+		Amount:    amount,
+		Status:    HoldStatusActive,
+		Reference: reference,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsExpired reports whether an active hold has outlived its TTL
+func (h *Hold) IsExpired(now time.Time) bool {
+	return h.Status == HoldStatusActive && !now.Before(h.ExpiresAt)
+}
+
+// Capture settles the hold for the captured amount; whatever is left is released
+func (h *Hold) Capture(amount valueobject.Money, now time.Time) error {
+	if h.Status != HoldStatusActive {
+		return apperrors.ErrHoldNotActive
+	}
+	if h.IsExpired(now) {
+		return apperrors.ErrHoldExpired
+	}
+	if amount.IsGreaterThan(h.Amount) {
+		return apperrors.ErrCaptureAmount
+	}
+
+	h.CapturedAmount = amount
+	h.Status = HoldStatusCaptured
+	h.UpdatedAt = now
+
+	return nil
+}
+
+// Void releases the hold without taking any money
+func (h *Hold) Void(now time.Time) error {
+	if h.Status != HoldStatusActive {
+		return apperrors.ErrHoldNotActive
+	}
+
+	h.Status = HoldStatusVoided
+	h.UpdatedAt = now
+
+	return nil
+}
+
+// Expire releases a hold whose TTL has passed
+func (h *Hold) Expire(now time.Time) error {
+	if !h.IsExpired(now) {
+		return apperrors.ErrHoldNotActive
+	}
+
+	h.Status = HoldStatusExpired
+	h.UpdatedAt = now
+
+	return nil
+}
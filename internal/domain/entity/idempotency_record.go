@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// IdempotencyRecord stores the outcome of a mutating request so that retries
+// with the same Idempotency-Key return the original response
+type IdempotencyRecord struct {
+	ID          int64
+	ClientID    int64
+	Key         string
+	Operation   string
+	RequestHash string
+	Response    string // JSON-encoded response body
+	CreatedAt   time.Time
+}
+
+func NewIdempotencyRecord(clientID int64, key, operation, requestHash, response string) *IdempotencyRecord {
+	return &IdempotencyRecord{
+		ClientID:    clientID,
+		Key:         key,
+		Operation:   operation,
+		RequestHash: requestHash,
+		Response:    response,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// Matches reports whether a retried request is identical to the stored one
+func (r *IdempotencyRecord) Matches(operation, requestHash string) bool {
+	return r.Operation == operation && r.RequestHash == requestHash
+}
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"e-wallet/internal/domain/valueobject"
+	"time"
+)
+
+// IdentificationEvent is an audit record of a wallet identification status change
+type IdentificationEvent struct {
+	ID            int64
+	WalletID      int64
+	SubmissionID  int64
+	FromStatus    valueobject.IdentificationStatus
+	ToStatus      valueobject.IdentificationStatus
+	ActorClientID int64 // API client that made the change: the partner on submit, the admin on review
+	Reason        string
+	CreatedAt     time.Time
+}
+
+func NewIdentificationEvent(walletID, submissionID int64, from, to valueobject.IdentificationStatus, actorClientID int64, reason string) *IdentificationEvent {
+	return &IdentificationEvent{
+		WalletID:      walletID,
+		SubmissionID:  submissionID,
+		FromStatus:    from,
+		ToStatus:      to,
+		ActorClientID: actorClientID,
+		Reason:        reason,
+		CreatedAt:     time.Now(),
+	}
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"e-wallet/internal/domain/valueobject"
+	apperrors "e-wallet/pkg/errors"
+	"time"
+)
+
+type JournalEntryKind string
+
+const (
+	JournalEntryKindOpeningBalance JournalEntryKind = "opening_balance"
+	JournalEntryKindDeposit        JournalEntryKind = "deposit"
+	JournalEntryKindWithdrawal     JournalEntryKind = "withdrawal"
+	JournalEntryKindTransfer       JournalEntryKind = "transfer"
+	JournalEntryKindReversal       JournalEntryKind = "reversal"
+	JournalEntryKindHoldCapture    JournalEntryKind = "hold_capture"
+	JournalEntryKindFloatTopUp     JournalEntryKind = "float_top_up"
+	JournalEntryKindFee            JournalEntryKind = "fee"
+	JournalEntryKindFeeRefund      JournalEntryKind = "fee_refund"
+)
+
+type PostingDirection string
+
+const (
+	PostingDirectionDebit  PostingDirection = "debit"
+	PostingDirectionCredit PostingDirection = "credit"
+)
+
+// Posting is one leg of a journal entry
+type Posting struct {
+	ID        int64
+	AccountID int64
+	Direction PostingDirection
+	Amount    valueobject.Money
+}
+
+// JournalEntry is a balanced set of postings recording one money movement
+type JournalEntry struct {
+	ID        int64
+	Kind      JournalEntryKind
+	Reference string // matches transactions.reference of the operation
+	Postings  []Posting
+	CreatedAt time.Time
+}
+
+func NewJournalEntry(kind JournalEntryKind, reference string) *JournalEntry {
+	return &JournalEntry{
+		Kind:      kind,
+		Reference: reference,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (e *JournalEntry) Debit(accountID int64, amount valueobject.Money) {
+	e.Postings = append(e.Postings, Posting{AccountID: accountID, Direction: PostingDirectionDebit, Amount: amount})
+}
+
+func (e *JournalEntry) Credit(accountID int64, amount valueobject.Money) {
+	e.Postings = append(e.Postings, Posting{AccountID: accountID, Direction: PostingDirectionCredit, Amount: amount})
+}
+
+// Validate checks that the entry has postings with positive amounts and that debits equal credits
+func (e *JournalEntry) Validate() error {
+	if len(e.Postings) < 2 {
+		return apperrors.ErrUnbalancedEntry
+	}
+
+	var debits, credits int64
+	for _, posting := range e.Postings {
+		if posting.Amount.Amount() <= 0 {
+			return apperrors.ErrUnbalancedEntry
+		}
+		if posting.Direction == PostingDirectionDebit {
+			debits += posting.Amount.Amount()
+		} else {
+			credits += posting.Amount.Amount()
+		}
+	}
+
+	if debits != credits {
+		return apperrors.ErrUnbalancedEntry
+	}
+	return nil
+}
+
+// BalanceChange returns the signed effect of a posting on its account balance (credits minus debits)
+func (p Posting) BalanceChange() int64 {
+	if p.Direction == PostingDirectionDebit {
+		return -p.Amount.Amount()
+	}
+	return p.Amount.Amount()
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	apperrors "e-wallet/pkg/errors"
+	"time"
+)
+
+// KYC document types accepted for identification
+const (
+	DocumentTypePassport        = "passport"
+	DocumentTypeNationalID      = "national_id"
+	DocumentTypeResidencePermit = "residence_permit"
+)
+
+type KYCSubmissionStatus string
+
+const (
+	KYCSubmissionStatusPending  KYCSubmissionStatus = "pending"
+	KYCSubmissionStatusApproved KYCSubmissionStatus = "approved"
+	KYCSubmissionStatusRejected KYCSubmissionStatus = "rejected"
+)
+
+// KYCSubmission holds the customer data a partner submitted to identify a wallet
+type KYCSubmission struct {
+	ID             int64
+	WalletID       int64
+	ClientID       int64 // partner that submitted the data
+	FullName       string
+	DocumentType   string
+	DocumentNumber string
+	DateOfBirth    time.Time
+	Status         KYCSubmissionStatus
+	ReviewReason   string
+	ReviewedAt     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewKYCSubmission(walletID, clientID int64, fullName, documentType, documentNumber string, dateOfBirth time.Time) *KYCSubmission {
+	now := time.Now()
+	return &KYCSubmission{
+		WalletID:       walletID,
+		ClientID:       clientID,
+		FullName:       fullName,
+		DocumentType:   documentType,
+		DocumentNumber: documentNumber,
+		DateOfBirth:    dateOfBirth,
+		Status:         KYCSubmissionStatusPending,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Review records the reviewer's decision on a pending submission
+func (s *KYCSubmission) Review(approved bool, reason string) error {
+	if s.Status != KYCSubmissionStatusPending {
+		return apperrors.ErrNoPendingReview
+	}
+
+	now := time.Now()
+	s.Status = KYCSubmissionStatusRejected
+	if approved {
+		s.Status = KYCSubmissionStatusApproved
+	}
+	s.ReviewReason = reason
+	s.ReviewedAt = &now
+	s.UpdatedAt = now
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+type LedgerAccountType string
+
+const (
+	LedgerAccountTypeWallet         LedgerAccountType = "wallet"          // customer money held in a wallet
+	LedgerAccountTypePartnerFloat   LedgerAccountType = "partner_float"   // money settled with a partner for cash-in and cash-out
+	LedgerAccountTypeFeeRevenue     LedgerAccountType = "fee_revenue"     // fees charged to wallets
+	LedgerAccountTypeOpeningBalance LedgerAccountType = "opening_balance" // counterpart of balances that existed before the ledger
+	LedgerAccountTypeSettlement     LedgerAccountType = "settlement"      // money partners transferred to prefund their floats
+)
+
+// System account codes
+const (
+	LedgerAccountCodeFeeRevenue     = "system:fee_revenue"
+	LedgerAccountCodeOpeningBalance = "system:opening_balance"
+	LedgerAccountCodeSettlement     = "system:settlement"
+)
+
+// LedgerAccount is an account of the double-entry ledger.
+// Balance is credits minus debits, so a wallet account balance equals the wallet balance.
+type LedgerAccount struct {
+	ID        int64
+	Code      string
+	Type      LedgerAccountType
+	WalletID  int64 // set for wallet accounts
+	ClientID  int64 // set for partner float accounts
+	Balance   int64 // (dirams), may be negative for system accounts; never negative for partner floats
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewWalletLedgerAccount(walletID int64) *LedgerAccount {
+	return &LedgerAccount{
+		Code:     fmt.Sprintf("wallet:%d", walletID),
+		Type:     LedgerAccountTypeWallet,
+		WalletID: walletID,
+	}
+}
+
+func NewPartnerFloatLedgerAccount(clientID int64) *LedgerAccount {
+	return &LedgerAccount{
+		Code:     fmt.Sprintf("partner_float:%d", clientID),
+		Type:     LedgerAccountTypePartnerFloat,
+		ClientID: clientID,
+	}
+}
+
+func NewSystemLedgerAccount(code string, accountType LedgerAccountType) *LedgerAccount {
+	return &LedgerAccount{
+		Code: code,
+		Type: accountType,
+	}
+}
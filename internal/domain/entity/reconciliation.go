@@ -0,0 +1,55 @@
+package entity
+
+import "time"
+
+// ReconciliationRun is the report of one comparison of stored wallet balances
+// with balances recomputed from the transactions table
+type ReconciliationRun struct {
+	ID             int64
+	ActorClientID  int64 // admin API client that started the run; 0 when run from the command line
+	WalletsChecked int64
+	TotalStored    int64 // sum of wallets.balance (dirams)
+	TotalComputed  int64 // sum of recomputed balances (dirams)
+	Discrepancies  []*ReconciliationDiscrepancy
+	StartedAt      time.Time
+	FinishedAt     time.Time
+}
+
+// ReconciliationDiscrepancy is a wallet whose stored balance differs from its transactions
+type ReconciliationDiscrepancy struct {
+	ID              int64
+	RunID           int64
+	WalletID        int64
+	AccountID       string
+	StoredBalance   int64 // (dirams)
+	ComputedBalance int64 // (dirams)
+	CreatedAt       time.Time
+}
+
+func NewReconciliationRun(actorClientID int64) *ReconciliationRun {
+	return &ReconciliationRun{
+		ActorClientID: actorClientID,
+		StartedAt:     time.Now(),
+	}
+}
+
+// Check adds a wallet to the run and records a discrepancy when its balances differ
+func (r *ReconciliationRun) Check(walletID int64, accountID string, stored, computed int64) {
+	r.WalletsChecked++
+	r.TotalStored += stored
+	r.TotalComputed += computed
+
+	if stored != computed {
+		r.Discrepancies = append(r.Discrepancies, &ReconciliationDiscrepancy{
+			WalletID:        walletID,
+			AccountID:       accountID,
+			StoredBalance:   stored,
+			ComputedBalance: computed,
+		})
+	}
+}
+
+// Difference returns how much the stored balance exceeds the recomputed one
+func (d *ReconciliationDiscrepancy) Difference() int64 {
+	return d.StoredBalance - d.ComputedBalance
+}
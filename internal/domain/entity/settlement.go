@@ -0,0 +1,78 @@
+package entity
+
+import "time"
+
+// Settlement is the daily statement of one partner: what it moved through customer wallets
+// on one business day and the net amount it owes for that day.
+// Settlements are generated once a business day has ended and are never changed afterwards:
+// a reversal of an older operation is settled on the day it was made.
+type Settlement struct {
+	ID           int64
+	ClientID     int64
+	BusinessDate time.Time // the business day, as midnight UTC of its date
+
+	DepositCount             int64
+	DepositAmount            int64 // (dirams)
+	DepositReversalCount     int64
+	DepositReversalAmount    int64 // (dirams)
+	WithdrawalCount          int64
+	WithdrawalAmount         int64 // (dirams)
+	WithdrawalReversalCount  int64
+	WithdrawalReversalAmount int64 // (dirams)
+	CaptureCount             int64
+	CaptureAmount            int64 // (dirams)
+	FeeCount                 int64
+	FeeAmount                int64 // (dirams)
+	FeeRefundCount           int64
+	FeeRefundAmount          int64 // (dirams)
+
+	// NetAmount is what the partner owes the operator for the day: deposits, withdrawal reversals and
+	// fees net of their refunds, minus withdrawals, deposit reversals and hold captures paid out to it.
+	// It equals how much the day drew down the partner's float. Positive means the partner owes the
+	// operator, negative the opposite.
+	NetAmount int64 // (dirams)
+
+	GeneratedAt time.Time
+}
+
+func NewSettlement(clientID int64, businessDate time.Time) *Settlement {
+	return &Settlement{
+		ClientID:     clientID,
+		BusinessDate: time.Date(businessDate.Year(), businessDate.Month(), businessDate.Day(), 0, 0, 0, 0, time.UTC),
+		GeneratedAt:  time.Now(),
+	}
+}
+
+// Add records the day's transactions of one type; types that are not settled are ignored
+func (s *Settlement) Add(txType TransactionType, count, amount int64) {
+	switch txType {
+	case TransactionTypeDeposit:
+		s.DepositCount += count
+		s.DepositAmount += amount
+		s.NetAmount += amount
+	case TransactionTypeDepositReversal:
+		s.DepositReversalCount += count
+		s.DepositReversalAmount += amount
+		s.NetAmount -= amount
+	case TransactionTypeWithdrawal:
+		s.WithdrawalCount += count
+		s.WithdrawalAmount += amount
+		s.NetAmount -= amount
+	case TransactionTypeWithdrawalReversal:
+		s.WithdrawalReversalCount += count
+		s.WithdrawalReversalAmount += amount
+		s.NetAmount += amount
+	case TransactionTypeHoldCapture:
+		s.CaptureCount += count
+		s.CaptureAmount += amount
+		s.NetAmount -= amount
+	case TransactionTypeFee:
+		s.FeeCount += count
+		s.FeeAmount += amount
+		s.NetAmount += amount
+	case TransactionTypeFeeRefund:
+		s.FeeRefundCount += count
+		s.FeeRefundAmount += amount
+		s.NetAmount -= amount
+	}
+}
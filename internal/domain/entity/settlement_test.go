@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSettlementNetAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		add     map[TransactionType]int64 // amount of each type, one transaction each
+		wantNet int64
+	}{
+		{name: "deposits draw the float", add: map[TransactionType]int64{TransactionTypeDeposit: 10000}, wantNet: 10000},
+		{name: "withdrawals pay it back", add: map[TransactionType]int64{TransactionTypeDeposit: 10000, TransactionTypeWithdrawal: 4000}, wantNet: 6000},
+		{name: "reversals and captures", add: map[TransactionType]int64{
+			TransactionTypeDeposit:            10000,
+			TransactionTypeDepositReversal:    3000,
+			TransactionTypeWithdrawalReversal: 500,
+			TransactionTypeHoldCapture:        2000,
+		}, wantNet: 5500},
+		{name: "fees are owed by the partner", add: map[TransactionType]int64{TransactionTypeDeposit: 10000, TransactionTypeFee: 200}, wantNet: 10200},
+		{name: "fee refunds are deducted", add: map[TransactionType]int64{
+			TransactionTypeDeposit:         10000,
+			TransactionTypeFee:             200,
+			TransactionTypeDepositReversal: 4000,
+			TransactionTypeFeeRefund:       80,
+		}, wantNet: 6120},
+		{name: "types that are not settled are ignored", add: map[TransactionType]int64{TransactionTypeTransferIn: 7000, TransactionTypeTransferOut: 7000}, wantNet: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settlement := NewSettlement(1, time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC))
+			for txType, amount := range tt.add {
+				settlement.Add(txType, 1, amount)
+			}
+			if settlement.NetAmount != tt.wantNet {
+				t.Errorf("net amount %d, want %d", settlement.NetAmount, tt.wantNet)
+			}
+		})
+	}
+}
+
+func TestSettlementReportsFees(t *testing.T) {
+	settlement := NewSettlement(1, time.Now())
+	settlement.Add(TransactionTypeFee, 12, 2400)
+	settlement.Add(TransactionTypeFeeRefund, 1, 200)
+
+	if settlement.FeeCount != 12 || settlement.FeeAmount != 2400 {
+		t.Errorf("fees %d for %d, want 12 for 2400", settlement.FeeCount, settlement.FeeAmount)
+	}
+	if settlement.FeeRefundCount != 1 || settlement.FeeRefundAmount != 200 {
+		t.Errorf("fee refunds %d for %d, want 1 for 200", settlement.FeeRefundCount, settlement.FeeRefundAmount)
+	}
+	if settlement.NetAmount != 2200 {
+		t.Errorf("net amount %d, want the fees net of refunds 2200", settlement.NetAmount)
+	}
+}
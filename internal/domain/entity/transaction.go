@@ -2,28 +2,178 @@ package entity
 
 import (
 	"e-wallet/internal/domain/valueobject"
+	apperrors "e-wallet/pkg/errors"
 	"time"
 )
 
 type TransactionType string
 
 const (
-	TransactionTypeDeposit TransactionType = "deposit"
+	TransactionTypeDeposit     TransactionType = "deposit"
+	TransactionTypeWithdrawal  TransactionType = "withdrawal"
+	TransactionTypeTransferOut TransactionType = "transfer_out"
+	TransactionTypeTransferIn  TransactionType = "transfer_in"
+
+	// TransactionTypeOpeningBalance records a balance that existed before transactions were tracked
+	TransactionTypeOpeningBalance TransactionType = "opening_balance"
+
+	// Reversals compensate all or part of an earlier deposit or withdrawal
+	TransactionTypeDepositReversal    TransactionType = "deposit_reversal"
+	TransactionTypeWithdrawalReversal TransactionType = "withdrawal_reversal"
+
+	// TransactionTypeHoldCapture takes the captured part of a hold from the wallet
+	TransactionTypeHoldCapture TransactionType = "hold_capture"
+
+	// TransactionTypeFee records the fee the partner paid from its float for the operation with the same
+	// reference. It is kept on the operation's wallet but does not change its balance.
+	TransactionTypeFee TransactionType = "fee"
+	// TransactionTypeFeeRefund returns to the partner's float the share of a fee whose operation was reversed
+	TransactionTypeFeeRefund TransactionType = "fee_refund"
+)
+
+// TransactionStatus is the processing state of a transaction
+type TransactionStatus string
+
+const (
+	// TransactionStatusPending is accepted but not applied to the balance yet
+	TransactionStatusPending TransactionStatus = "pending"
+	// TransactionStatusCompleted is applied to the balance
+	TransactionStatusCompleted TransactionStatus = "completed"
+	// TransactionStatusFailed was rejected without changing the balance
+	TransactionStatusFailed TransactionStatus = "failed"
+	// TransactionStatusReversed was completed and then compensated in full or in part by a reversal
+	TransactionStatusReversed TransactionStatus = "reversed"
+)
+
+// Transaction types by their effect on the wallet balance; fees and their refunds have none
+var (
+	CreditTransactionTypes = []TransactionType{TransactionTypeDeposit, TransactionTypeTransferIn, TransactionTypeOpeningBalance,
+		TransactionTypeWithdrawalReversal}
+	DebitTransactionTypes = []TransactionType{TransactionTypeWithdrawal, TransactionTypeTransferOut, TransactionTypeDepositReversal,
+		TransactionTypeHoldCapture}
 )
 
+// IsValid reports whether the type is a known transaction type
+func (t TransactionType) IsValid() bool {
+	switch t {
+	case TransactionTypeDeposit, TransactionTypeWithdrawal, TransactionTypeTransferOut, TransactionTypeTransferIn,
+		TransactionTypeOpeningBalance, TransactionTypeDepositReversal, TransactionTypeWithdrawalReversal,
+		TransactionTypeHoldCapture, TransactionTypeFee, TransactionTypeFeeRefund:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValid reports whether the status is a known transaction status
+func (s TransactionStatus) IsValid() bool {
+	switch s {
+	case TransactionStatusPending, TransactionStatusCompleted, TransactionStatusFailed, TransactionStatusReversed:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReversalType returns the type of the transaction that reverses this one.
+// Only deposits and withdrawals can be reversed; transfers involve two wallets and are not.
+func (t TransactionType) ReversalType() (TransactionType, bool) {
+	switch t {
+	case TransactionTypeDeposit:
+		return TransactionTypeDepositReversal, true
+	case TransactionTypeWithdrawal:
+		return TransactionTypeWithdrawalReversal, true
+	default:
+		return "", false
+	}
+}
+
 type Transaction struct {
-	ID        int64
-	WalletID  int64
-	Type      TransactionType
-	Amount    valueobject.Money
-	CreatedAt time.Time
+	ID                    int64
+	WalletID              int64
+	ClientID              int64 // API client that made the operation; 0 for rows recorded before it was tracked
+	Type                  TransactionType
+	Amount                valueobject.Money
+	BalanceAfter          *valueobject.Money // wallet balance after this operation; nil for rows recorded before it was tracked
+	Reference             string             // shared by linked rows, e.g. both legs of a transfer
+	OriginalTransactionID *int64             // set on reversals: the transaction being reversed
+	FeeRuleID             *int64             // set on fees: the fee rule that priced it
+	ExternalID            string             // the partner's own ID of the operation, unique per client; empty when not given
+	Description           string
+	Metadata              map[string]string // free-form key/value pairs of the partner; nil when not given
+	Status                TransactionStatus
+	ReversedAmount        valueobject.Money // total compensated by reversals so far
+	CreatedAt             time.Time
+	UpdatedAt             time.Time // last status change
 }
 
-func NewTransaction(walletID int64, txType TransactionType, amount valueobject.Money) *Transaction {
+// NewTransaction creates a completed transaction: operations are applied in the same DB transaction that records them
+func NewTransaction(walletID, clientID int64, txType TransactionType, amount, balanceAfter valueobject.Money) *Transaction {
+	now := time.Now()
 	return &Transaction{
-		WalletID:  walletID,
-		Type:      txType,
-		Amount:    amount,
-		CreatedAt: time.Now(),
+		WalletID:     walletID,
+		ClientID:     clientID,
+		Type:         txType,
+		Amount:       amount,
+		BalanceAfter: &balanceAfter,
+		Status:       TransactionStatusCompleted,
+		CreatedAt:    now,
+		UpdatedAt:    now,
 	}
 }
+
+// ReversibleAmount returns the part of the transaction that has not been reversed yet
+func (t *Transaction) ReversibleAmount() valueobject.Money {
+	remaining, err := t.Amount.Subtract(t.ReversedAmount)
+	if err != nil {
+		return valueobject.Money{}
+	}
+	return remaining
+}
+
+// ApplyReversal records that a reversal compensated amount of the transaction.
+// A transaction may be reversed in several parts as long as they add up to at most its amount.
+func (t *Transaction) ApplyReversal(amount valueobject.Money) error {
+	if t.Status != TransactionStatusCompleted && t.Status != TransactionStatusReversed {
+		return apperrors.ErrNotReversible
+	}
+	if amount.IsGreaterThan(t.ReversibleAmount()) {
+		return apperrors.ErrReversalAmount
+	}
+
+	t.ReversedAmount = t.ReversedAmount.Add(amount)
+	t.Status = TransactionStatusReversed
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// FeeRefund returns how much of the operation's fee is still owed back to the partner: the fee's
+// share of the reversed amount, rounded down, minus what was refunded before. Once the operation
+// is reversed in full the whole fee has been refunded.
+func (t *Transaction) FeeRefund(fee *Transaction) valueobject.Money {
+	owed := fee.Amount
+	if t.ReversedAmount.IsLessThan(t.Amount) {
+		owed, _ = valueobject.NewMoneyFromMinor(fee.Amount.Amount() * t.ReversedAmount.Amount() / t.Amount.Amount())
+	}
+
+	refund, err := owed.Subtract(fee.ReversedAmount)
+	if err != nil {
+		return valueobject.Money{}
+	}
+	return refund
+}
+
+// MadeBy reports whether the transaction was made by the given API client.
+// Rows recorded before the client was tracked belong to the owner of their wallet.
+func (t *Transaction) MadeBy(clientID int64, wallet *Wallet) bool {
+	if t.ClientID == 0 {
+		return wallet.IsOwnedBy(clientID)
+	}
+	return t.ClientID == clientID
+}
+
+// VisibleTo reports whether the API client may look the transaction up:
+// it made the transaction or owns the wallet it belongs to
+func (t *Transaction) VisibleTo(clientID int64, wallet *Wallet) bool {
+	return t.MadeBy(clientID, wallet) || wallet.IsOwnedBy(clientID)
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"e-wallet/internal/domain/valueobject"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+	"testing"
+)
+
+func money(t *testing.T, dirams int64) valueobject.Money {
+	t.Helper()
+	m, err := valueobject.NewMoney(dirams)
+	if err != nil {
+		t.Fatalf("money %d: %v", dirams, err)
+	}
+	return m
+}
+
+func TestTransactionFeeRefund(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		fee      int64
+		parts    []int64 // reversals in order
+		wantEach []int64 // fee refunded by each reversal
+	}{
+		{name: "full reversal refunds the whole fee", amount: 10000, fee: 200, parts: []int64{10000}, wantEach: []int64{200}},
+		{name: "partial reversal refunds its share", amount: 10000, fee: 200, parts: []int64{4000}, wantEach: []int64{80}},
+		{name: "parts add up to the fee", amount: 10000, fee: 200, parts: []int64{4000, 5000, 1000}, wantEach: []int64{80, 100, 20}},
+		{name: "rounding down leaves the rest to the last part", amount: 3, fee: 1, parts: []int64{1, 1, 1}, wantEach: []int64{0, 0, 1}},
+		{name: "share below one diram is refunded later", amount: 10000, fee: 7, parts: []int64{1000, 9000}, wantEach: []int64{0, 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := NewTransaction(1, 1, TransactionTypeDeposit, money(t, tt.amount), money(t, tt.amount))
+			fee := NewTransaction(1, 1, TransactionTypeFee, money(t, tt.fee), money(t, 0))
+
+			var refunded int64
+			for i, part := range tt.parts {
+				if err := operation.ApplyReversal(money(t, part)); err != nil {
+					t.Fatalf("reversal %d: %v", i, err)
+				}
+				refund := operation.FeeRefund(fee)
+				if refund.Amount() != tt.wantEach[i] {
+					t.Errorf("reversal %d refunds %d, want %d", i, refund.Amount(), tt.wantEach[i])
+				}
+				if refund.Amount() > 0 {
+					if err := fee.ApplyReversal(refund); err != nil {
+						t.Fatalf("fee refund %d: %v", i, err)
+					}
+				}
+				refunded += refund.Amount()
+			}
+
+			if operation.ReversibleAmount().Amount() == 0 && refunded != tt.fee {
+				t.Errorf("refunded %d in total, want the whole fee %d", refunded, tt.fee)
+			}
+		})
+	}
+}
+
+func TestTransactionApplyReversalRejectsMoreThanTheAmount(t *testing.T) {
+	transaction := NewTransaction(1, 1, TransactionTypeWithdrawal, money(t, 1000), money(t, 0))
+
+	if err := transaction.ApplyReversal(money(t, 600)); err != nil {
+		t.Fatalf("first reversal: %v", err)
+	}
+	if err := transaction.ApplyReversal(money(t, 500)); err == nil {
+		t.Fatal("reversal beyond the amount succeeded")
+	}
+	if err := transaction.ApplyReversal(money(t, 400)); err != nil {
+		t.Fatalf("reversal of the rest: %v", err)
+	}
+	if transaction.Status != TransactionStatusReversed || transaction.ReversedAmount.Amount() != 1000 {
+		t.Errorf("status %s, reversed %d; want reversed 1000", transaction.Status, transaction.ReversedAmount.Amount())
+	}
+}
+
+func TestTransactionApplyReversalRequiresAnAppliedTransaction(t *testing.T) {
+	for _, status := range []TransactionStatus{TransactionStatusPending, TransactionStatusFailed} {
+		transaction := NewTransaction(1, 1, TransactionTypeDeposit, money(t, 1000), money(t, 1000))
+		transaction.Status = status
+
+		if err := transaction.ApplyReversal(money(t, 100)); !errors.Is(err, apperrors.ErrNotReversible) {
+			t.Errorf("%s transaction: error = %v, want TRANSACTION_NOT_REVERSIBLE", status, err)
+		}
+		if transaction.Status != status || transaction.ReversedAmount.Amount() != 0 {
+			t.Errorf("%s transaction changed to %s with %d reversed", status, transaction.Status, transaction.ReversedAmount.Amount())
+		}
+	}
+}
@@ -8,15 +8,95 @@ import (
 
 // Wallet represents a wallet account entity
 type Wallet struct {
-	ID        int64
-	AccountID valueobject.AccountID
-	Type      valueobject.WalletType
-	Balance   valueobject.Money
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID                   int64
+	AccountID            valueobject.AccountID
+	Type                 valueobject.WalletType
+	Status               valueobject.WalletStatus
+	IdentificationStatus valueobject.IdentificationStatus
+	Balance              valueobject.Money
+	HeldAmount           valueobject.Money // reserved by active holds; still part of Balance but not available
+	OwnerClientID        int64             // API client the wallet belongs to; 0 if unassigned
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// NewWallet creates an empty wallet owned by the given API client.
+// It is never identified: only an approved identification marks a wallet identified.
+func NewWallet(accountID valueobject.AccountID, walletType valueobject.WalletType, ownerClientID int64) *Wallet {
+	now := time.Now()
+	return &Wallet{
+		AccountID:            accountID,
+		Type:                 walletType,
+		Status:               valueobject.WalletStatusActive,
+		IdentificationStatus: valueobject.IdentificationStatusNone,
+		OwnerClientID:        ownerClientID,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+}
+
+// AvailableBalance returns the part of the balance that is not reserved by holds
+func (w *Wallet) AvailableBalance() valueobject.Money {
+	available, err := w.Balance.Subtract(w.HeldAmount)
+	if err != nil {
+		return valueobject.Money{}
+	}
+	return available
+}
+
+// IsOwnedBy reports whether the wallet belongs to the given API client
+func (w *Wallet) IsOwnedBy(clientID int64) bool {
+	return w.OwnerClientID != 0 && w.OwnerClientID == clientID
+}
+
+// EnsureAccessible rejects any operation on blocked and closed wallets
+func (w *Wallet) EnsureAccessible() error {
+	switch w.Status {
+	case valueobject.WalletStatusBlocked:
+		return apperrors.ErrWalletBlocked
+	case valueobject.WalletStatusClosed:
+		return apperrors.ErrWalletClosed
+	default:
+		return nil
+	}
+}
+
+// CanCredit reports whether money may be added to the wallet; frozen wallets still accept credits
+func (w *Wallet) CanCredit() error {
+	return w.EnsureAccessible()
+}
+
+// CanDebit reports whether money may be taken from the wallet; only active wallets allow debits
+func (w *Wallet) CanDebit() error {
+	if err := w.EnsureAccessible(); err != nil {
+		return err
+	}
+	if w.Status == valueobject.WalletStatusFrozen {
+		return apperrors.ErrWalletFrozen
+	}
+	return nil
+}
+
+// ChangeStatus moves the wallet to a new lifecycle status. A wallet can only be closed with a zero balance.
+func (w *Wallet) ChangeStatus(next valueobject.WalletStatus) error {
+	if !w.Status.CanTransitionTo(next) {
+		return apperrors.ErrInvalidStatusChange
+	}
+	if next == valueobject.WalletStatusClosed && w.Balance.Amount() != 0 {
+		return apperrors.ErrBalanceNotZero
+	}
+
+	w.Status = next
+	w.UpdatedAt = time.Now()
+
+	return nil
 }
 
 func (w *Wallet) CanDeposit(amount valueobject.Money) error {
+	if err := w.CanCredit(); err != nil {
+		return err
+	}
+
 	newBalance := w.Balance.Add(amount)
 	maxBalance, err := w.Type.MaxBalance()
 	if err != nil {
@@ -40,3 +120,131 @@ func (w *Wallet) Deposit(amount valueobject.Money) error {
 
 	return nil
 }
+
+func (w *Wallet) CanWithdraw(amount valueobject.Money) error {
+	if err := w.CanDebit(); err != nil {
+		return err
+	}
+
+	if _, err := w.AvailableBalance().Subtract(amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (w *Wallet) Withdraw(amount valueobject.Money) error {
+	if err := w.CanDebit(); err != nil {
+		return err
+	}
+
+	if _, err := w.AvailableBalance().Subtract(amount); err != nil {
+		return err
+	}
+
+	newBalance, err := w.Balance.Subtract(amount)
+	if err != nil {
+		return err
+	}
+
+	w.Balance = newBalance
+	w.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// Hold reserves part of the available balance; like a withdrawal it needs an active wallet
+func (w *Wallet) Hold(amount valueobject.Money) error {
+	if err := w.CanDebit(); err != nil {
+		return err
+	}
+
+	if _, err := w.AvailableBalance().Subtract(amount); err != nil {
+		return err
+	}
+
+	w.HeldAmount = w.HeldAmount.Add(amount)
+	w.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// ReleaseHold makes a reserved amount available again
+func (w *Wallet) ReleaseHold(amount valueobject.Money) error {
+	heldAmount, err := w.HeldAmount.Subtract(amount)
+	if err != nil {
+		return err
+	}
+
+	w.HeldAmount = heldAmount
+	w.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// CaptureHold releases a reserved amount and takes the captured part of it from the balance
+func (w *Wallet) CaptureHold(held, captured valueobject.Money) error {
+	if err := w.CanDebit(); err != nil {
+		return err
+	}
+	if captured.IsGreaterThan(held) {
+		return apperrors.ErrCaptureAmount
+	}
+
+	if err := w.ReleaseHold(held); err != nil {
+		return err
+	}
+
+	newBalance, err := w.Balance.Subtract(captured)
+	if err != nil {
+		return err
+	}
+
+	w.Balance = newBalance
+
+	return nil
+}
+
+// SubmitIdentification moves an unidentified wallet to pending identification.
+// Rejected wallets may be resubmitted.
+func (w *Wallet) SubmitIdentification() error {
+	if w.Type.IsIdentified() || w.IdentificationStatus == valueobject.IdentificationStatusIdentified {
+		return apperrors.ErrAlreadyIdentified
+	}
+	if w.IdentificationStatus == valueobject.IdentificationStatusPending {
+		return apperrors.ErrIdentificationPending
+	}
+
+	w.IdentificationStatus = valueobject.IdentificationStatusPending
+	w.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// ApproveIdentification promotes a pending wallet to the granted tier and its higher limits
+func (w *Wallet) ApproveIdentification(tier valueobject.WalletType) error {
+	if w.IdentificationStatus != valueobject.IdentificationStatusPending {
+		return apperrors.ErrNoPendingReview
+	}
+	if !tier.RequiresIdentification() {
+		return apperrors.ErrInvalidWalletType
+	}
+
+	w.Type = tier
+	w.IdentificationStatus = valueobject.IdentificationStatusIdentified
+	w.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// RejectIdentification returns a pending wallet to unidentified use
+func (w *Wallet) RejectIdentification() error {
+	if w.IdentificationStatus != valueobject.IdentificationStatusPending {
+		return apperrors.ErrNoPendingReview
+	}
+
+	w.IdentificationStatus = valueobject.IdentificationStatusRejected
+	w.UpdatedAt = time.Now()
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"e-wallet/internal/domain/valueobject"
+	"time"
+)
+
+// WalletStatusChange is an audit record of a wallet lifecycle status change
+type WalletStatusChange struct {
+	ID            int64
+	WalletID      int64
+	FromStatus    valueobject.WalletStatus
+	ToStatus      valueobject.WalletStatus
+	Reason        string
+	ActorClientID int64 // admin API client that made the change
+	CreatedAt     time.Time
+}
+
+func NewWalletStatusChange(walletID int64, from, to valueobject.WalletStatus, reason string, actorClientID int64) *WalletStatusChange {
+	return &WalletStatusChange{
+		WalletID:      walletID,
+		FromStatus:    from,
+		ToStatus:      to,
+		Reason:        reason,
+		ActorClientID: actorClientID,
+		CreatedAt:     time.Now(),
+	}
+}
@@ -0,0 +1,182 @@
+package entity
+
+import (
+	apperrors "e-wallet/pkg/errors"
+	"time"
+)
+
+// WebhookEventType names an event partners can subscribe to
+type WebhookEventType string
+
+const (
+	WebhookEventDepositCompleted   WebhookEventType = "deposit.completed"
+	WebhookEventWalletLimitReached WebhookEventType = "wallet.limit_reached"
+	WebhookEventWalletStatusChange WebhookEventType = "wallet.status_changed"
+)
+
+// WebhookEventTypes lists every event type, in the order they are documented
+var WebhookEventTypes = []WebhookEventType{
+	WebhookEventDepositCompleted,
+	WebhookEventWalletLimitReached,
+	WebhookEventWalletStatusChange,
+}
+
+// IsValid reports whether the type is a known event type
+func (t WebhookEventType) IsValid() bool {
+	for _, eventType := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSubscription is an endpoint of an API client that receives events
+type WebhookSubscription struct {
+	ID         int64
+	ClientID   int64
+	URL        string
+	EventTypes []WebhookEventType // empty means every event type
+	IsActive   bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewWebhookSubscription(clientID int64, url string, eventTypes []WebhookEventType) *WebhookSubscription {
+	now := time.Now()
+	return &WebhookSubscription{
+		ClientID:   clientID,
+		URL:        url,
+		EventTypes: eventTypes,
+		IsActive:   true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// Wants reports whether the subscription receives events of the given type
+func (s *WebhookSubscription) Wants(eventType WebhookEventType) bool {
+	if !s.IsActive {
+		return false
+	}
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEvent is the JSON body delivered to subscribers
+type WebhookEvent struct {
+	ID        string           `json:"id"` // the same for every subscription, receivers can deduplicate on it
+	Type      WebhookEventType `json:"type"`
+	CreatedAt time.Time        `json:"created_at"`
+	Data      any              `json:"data"`
+}
+
+// WebhookDeliveryStatus is the state of one event delivery to one subscription
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryDead      WebhookDeliveryStatus = "dead" // gave up after the last attempt
+)
+
+// WebhookRetryPolicy bounds delivery attempts with exponential backoff
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration // delay after the first failed attempt, doubled after each further one
+	MaxDelay    time.Duration
+}
+
+// Backoff returns the delay before the next attempt after the given number of failed attempts
+func (p WebhookRetryPolicy) Backoff(attempts int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempts && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// WebhookDelivery is an outbox row: one event waiting to be delivered to one subscription
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	ClientID       int64
+	EventID        string
+	EventType      WebhookEventType
+	Payload        string // JSON-encoded WebhookEvent, signed and sent as is
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+	LastStatusCode int // HTTP status of the last attempt; 0 if no response was received
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewWebhookDelivery(subscription *WebhookSubscription, event *WebhookEvent, payload string) *WebhookDelivery {
+	return &WebhookDelivery{
+		SubscriptionID: subscription.ID,
+		ClientID:       subscription.ClientID,
+		EventID:        event.ID,
+		EventType:      event.Type,
+		Payload:        payload,
+		Status:         WebhookDeliveryPending,
+		NextAttemptAt:  event.CreatedAt,
+		CreatedAt:      event.CreatedAt,
+		UpdatedAt:      event.CreatedAt,
+	}
+}
+
+// MarkDelivered records a successful attempt
+func (d *WebhookDelivery) MarkDelivered(statusCode int, now time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryDelivered
+	d.LastStatusCode = statusCode
+	d.LastError = ""
+	d.DeliveredAt = &now
+	d.UpdatedAt = now
+}
+
+// MarkFailed records a failed attempt and schedules the next one, or moves the delivery
+// to the dead-letter state once the policy's attempts are used up
+func (d *WebhookDelivery) MarkFailed(reason string, statusCode int, now time.Time, policy WebhookRetryPolicy) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = reason
+	d.UpdatedAt = now
+
+	if d.Attempts >= policy.MaxAttempts {
+		d.Status = WebhookDeliveryDead
+		return
+	}
+	d.NextAttemptAt = now.Add(policy.Backoff(d.Attempts))
+}
+
+// MarkDead moves the delivery to the dead-letter state without further attempts
+func (d *WebhookDelivery) MarkDead(reason string, now time.Time) {
+	d.Status = WebhookDeliveryDead
+	d.LastError = reason
+	d.UpdatedAt = now
+}
+
+// Redeliver queues a delivered or dead delivery again with a fresh set of attempts
+func (d *WebhookDelivery) Redeliver(now time.Time) error {
+	if d.Status == WebhookDeliveryPending {
+		return apperrors.ErrDeliveryPending
+	}
+
+	d.Status = WebhookDeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = now
+	d.UpdatedAt = now
+
+	return nil
+}
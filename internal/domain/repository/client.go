@@ -8,6 +8,7 @@ import (
 // ClientRepository defines the interface for client persistence
 type ClientRepository interface {
 	FindByUserID(ctx context.Context, userID string) (*entity.APIClient, error)
+	FindByID(ctx context.Context, id int64) (*entity.APIClient, error)
 	Create(ctx context.Context, client *entity.APIClient) error
 	Update(ctx context.Context, client *entity.APIClient) error
 }
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"time"
+)
+
+// FeeScheduleRepository defines the interface for fee schedule persistence
+type FeeScheduleRepository interface {
+	// Create stores a schedule with its rules
+	Create(ctx context.Context, schedule *entity.FeeSchedule) error
+	// FindEffective returns the latest schedule of the client in effect at the given time,
+	// falling back to the latest default schedule; ErrRecordNotFound when neither exists
+	FindEffective(ctx context.Context, clientID int64, at time.Time) (*entity.FeeSchedule, error)
+	// FindByClient returns every version of the client's schedule (nil: the default schedule), newest first
+	FindByClient(ctx context.Context, clientID *int64) ([]*entity.FeeSchedule, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"time"
+)
+
+// HoldRepository defines the interface for hold persistence
+type HoldRepository interface {
+	Create(ctx context.Context, hold *entity.Hold) error
+	FindByID(ctx context.Context, id int64) (*entity.Hold, error)
+	FindByIDForUpdate(ctx context.Context, id int64) (*entity.Hold, error)
+	Update(ctx context.Context, hold *entity.Hold) error
+	// FindExpired returns up to limit active holds whose expiry is at or before now, oldest first,
+	// leaving out the holds in skip
+	FindExpired(ctx context.Context, now time.Time, skip []int64, limit int) ([]*entity.Hold, error)
+}
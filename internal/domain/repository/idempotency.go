@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+)
+
+// IdempotencyRepository defines the interface for idempotency record persistence
+type IdempotencyRepository interface {
+	FindByKey(ctx context.Context, clientID int64, key string) (*entity.IdempotencyRecord, error)
+	Create(ctx context.Context, record *entity.IdempotencyRecord) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+)
+
+// IdentificationRepository defines the interface for KYC submission and identification audit persistence
+type IdentificationRepository interface {
+	CreateSubmission(ctx context.Context, submission *entity.KYCSubmission) error
+	FindPendingSubmission(ctx context.Context, walletID int64) (*entity.KYCSubmission, error)
+	UpdateSubmission(ctx context.Context, submission *entity.KYCSubmission) error
+	CreateEvent(ctx context.Context, event *entity.IdentificationEvent) error
+	FindEventsByWalletID(ctx context.Context, walletID int64) ([]*entity.IdentificationEvent, error)
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"time"
+)
+
+// LedgerRepository defines the interface for double-entry ledger persistence
+type LedgerRepository interface {
+	// FindOrCreateAccount returns the account with the given code, creating it if needed.
+	// created reports whether this call inserted the account.
+	FindOrCreateAccount(ctx context.Context, account *entity.LedgerAccount) (acc *entity.LedgerAccount, created bool, err error)
+	FindAccountByID(ctx context.Context, id int64) (*entity.LedgerAccount, error)
+	// FindAccountByIDForUpdate locks the account row until the surrounding transaction ends
+	FindAccountByIDForUpdate(ctx context.Context, id int64) (*entity.LedgerAccount, error)
+	// CreateEntry stores a validated entry with its postings and applies them to account balances
+	CreateEntry(ctx context.Context, entry *entity.JournalEntry) error
+	ExistsEntry(ctx context.Context, kind entity.JournalEntryKind, reference string) (bool, error)
+	// FindMovements returns postings of one account with their entries, newest first
+	FindMovements(ctx context.Context, filter LedgerMovementFilter) ([]*LedgerMovement, error)
+	GetTrialBalance(ctx context.Context) ([]*LedgerTypeBalance, error)
+	// FindDriftedAccounts returns accounts whose stored balance differs from the sum of their postings
+	FindDriftedAccounts(ctx context.Context) ([]*entity.LedgerAccount, error)
+}
+
+// LedgerTypeBalance aggregates ledger account balances of one account type
+type LedgerTypeBalance struct {
+	Type     entity.LedgerAccountType
+	Accounts int64
+	Balance  int64 // (dirams), credits minus debits
+}
+
+// LedgerMovementFilter selects a page of postings of one ledger account
+type LedgerMovementFilter struct {
+	AccountID int64
+	Limit     int
+	// Keyset pagination: return postings strictly older than (AfterCreatedAt, AfterID)
+	AfterCreatedAt *time.Time
+	AfterID        int64
+}
+
+// LedgerMovement is a posting of an account together with the entry it belongs to
+type LedgerMovement struct {
+	PostingID int64
+	Kind      entity.JournalEntryKind
+	Reference string
+	Direction entity.PostingDirection
+	Amount    int64 // (dirams)
+	CreatedAt time.Time
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// NonceRepository defines the interface for persisting request nonces
+type NonceRepository interface {
+	// Create stores a nonce; a nonce already seen for the client yields ErrAlreadyExists
+	Create(ctx context.Context, clientID int64, nonce string) error
+	DeleteOlderThan(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+)
+
+// ReconciliationRepository defines the interface for balance reconciliation persistence
+type ReconciliationRepository interface {
+	// ComputeWalletBalances returns every wallet's stored balance next to the balance
+	// recomputed from its transactions, read in a single statement
+	ComputeWalletBalances(ctx context.Context) ([]*WalletBalanceCheck, error)
+	// CreateRun stores the run report together with its discrepancies
+	CreateRun(ctx context.Context, run *entity.ReconciliationRun) error
+}
+
+// WalletBalanceCheck pairs a wallet's stored balance with the balance derived from its transactions
+type WalletBalanceCheck struct {
+	WalletID        int64
+	AccountID       string
+	StoredBalance   int64 // (dirams)
+	ComputedBalance int64 // (dirams)
+}
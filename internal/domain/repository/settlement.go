@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"time"
+)
+
+// SettlementRepository defines the interface for daily partner settlement persistence
+type SettlementRepository interface {
+	// AggregateDay sums the transactions made in [from, to) per partner and transaction type.
+	// Every non-admin partner that existed by then is returned, with an empty Type when it made no transactions.
+	AggregateDay(ctx context.Context, from, to time.Time) ([]*SettlementTotal, error)
+	// CreateMissing stores the settlements that do not exist yet for their partner and business date
+	// and returns how many were stored
+	CreateMissing(ctx context.Context, settlements []*entity.Settlement) (int64, error)
+	// FindByClient returns the partner's settlements for business dates in [from, to], oldest first
+	FindByClient(ctx context.Context, clientID int64, from, to time.Time) ([]*entity.Settlement, error)
+	// FindByDate returns the settlements of every partner for one business date
+	FindByDate(ctx context.Context, businessDate time.Time) ([]*entity.Settlement, error)
+}
+
+// SettlementTotal is the number and sum of one partner's transactions of one type
+type SettlementTotal struct {
+	ClientID int64
+	Type     string
+	Count    int64
+	Amount   int64 // (dirams)
+}
@@ -9,11 +9,60 @@ import (
 // TransactionRepository defines the interface for transaction persistence
 type TransactionRepository interface {
 	Create(ctx context.Context, transaction *entity.Transaction) error
-	FindByWalletID(ctx context.Context, walletID int64) ([]*entity.Transaction, error)
-	GetMonthlyStats(ctx context.Context, walletID int64, month time.Time) (*MonthlyStats, error)
+	FindByID(ctx context.Context, id int64) (*entity.Transaction, error)
+	// FindByIDForUpdate finds a transaction and locks its row until the surrounding transaction ends
+	FindByIDForUpdate(ctx context.Context, id int64) (*entity.Transaction, error)
+	// FindByExternalID finds the transaction the client created with the given external ID
+	FindByExternalID(ctx context.Context, clientID int64, externalID string) (*entity.Transaction, error)
+	// UpdateStatus stores the transaction's status, reversed amount and UpdatedAt
+	UpdateStatus(ctx context.Context, transaction *entity.Transaction) error
+	// FindFee finds the fee charged to the wallet for the operation with the given reference
+	FindFee(ctx context.Context, walletID int64, reference string) (*entity.Transaction, error)
+	// FindReversals returns the transactions that reversed the given one, oldest first
+	FindReversals(ctx context.Context, originalID int64) ([]*entity.Transaction, error)
+	FindPage(ctx context.Context, filter TransactionFilter) ([]*entity.Transaction, error)
+	GetPeriodStats(ctx context.Context, walletID int64, from, to time.Time, groupBy StatsGrouping, loc *time.Location) ([]*PeriodStats, error)
+	// GetTurnover counts and sums transactions of the given types since dayStart and since monthStart
+	GetTurnover(ctx context.Context, walletID int64, types []entity.TransactionType, dayStart, monthStart time.Time) (*Turnover, error)
 }
 
-type MonthlyStats struct {
+// Turnover aggregates a wallet's transactions over the current day and month
+type Turnover struct {
+	DailyCount    int64
+	DailyAmount   int64 // (dirams)
+	MonthlyCount  int64
+	MonthlyAmount int64 // (dirams)
+}
+
+// StatsGrouping is the bucket size of a statistics breakdown
+type StatsGrouping string
+
+const (
+	StatsGroupDay  StatsGrouping = "day"
+	StatsGroupWeek StatsGrouping = "week" // weeks start on Monday
+)
+
+// PeriodStats aggregates transactions of one type within one bucket
+type PeriodStats struct {
+	Period      time.Time // start of the bucket, as a midnight in the requested location
+	Type        entity.TransactionType
 	TotalCount  int64
 	TotalAmount int64 // (dirams)
 }
+
+// TransactionFilter selects one page of a wallet's history, newest first.
+// Nil or empty fields are not applied.
+type TransactionFilter struct {
+	WalletID  int64
+	Types     []entity.TransactionType
+	From      *time.Time // inclusive
+	To        *time.Time // exclusive
+	MinAmount *int64     // (dirams)
+	MaxAmount *int64     // (dirams)
+
+	// Keyset cursor: only rows strictly older than (AfterCreatedAt, AfterID) are returned
+	AfterCreatedAt *time.Time
+	AfterID        int64
+
+	Limit int
+}
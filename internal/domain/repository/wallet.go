@@ -7,9 +7,14 @@ import (
 )
 
 // WalletRepository defines the interface for wallet persistence
+// Account IDs are unique per owning partner only, so partner operations look wallets up by owner and account ID
 type WalletRepository interface {
 	FindByAccountID(ctx context.Context, accountID valueobject.AccountID) (*entity.Wallet, error)
+	FindByAccountIDForUpdate(ctx context.Context, accountID valueobject.AccountID) (*entity.Wallet, error)
+	FindOwnedByAccountID(ctx context.Context, ownerClientID int64, accountID valueobject.AccountID) (*entity.Wallet, error)
+	FindOwnedByAccountIDForUpdate(ctx context.Context, ownerClientID int64, accountID valueobject.AccountID) (*entity.Wallet, error)
 	FindByID(ctx context.Context, id int64) (*entity.Wallet, error)
+	FindByIDForUpdate(ctx context.Context, id int64) (*entity.Wallet, error)
 	Create(ctx context.Context, wallet *entity.Wallet) error
 	Update(ctx context.Context, wallet *entity.Wallet) error
 	ExistsByAccountID(ctx context.Context, accountID valueobject.AccountID) (bool, error)
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+)
+
+// WalletStatusChangeRepository defines the interface for wallet status audit persistence
+type WalletStatusChangeRepository interface {
+	Create(ctx context.Context, change *entity.WalletStatusChange) error
+	FindByWalletID(ctx context.Context, walletID int64) ([]*entity.WalletStatusChange, error)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"time"
+)
+
+// WebhookSubscriptionRepository defines the interface for webhook subscription persistence
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, subscription *entity.WebhookSubscription) error
+	Update(ctx context.Context, subscription *entity.WebhookSubscription) error
+	FindByID(ctx context.Context, id int64) (*entity.WebhookSubscription, error)
+	// FindByClient returns the client's subscriptions, oldest first; activeOnly skips removed ones
+	FindByClient(ctx context.Context, clientID int64, activeOnly bool) ([]*entity.WebhookSubscription, error)
+}
+
+// WebhookOutboxRepository defines the interface for the webhook outbox
+type WebhookOutboxRepository interface {
+	Create(ctx context.Context, delivery *entity.WebhookDelivery) error
+	Update(ctx context.Context, delivery *entity.WebhookDelivery) error
+	FindByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error)
+	// FindByClient returns the client's latest deliveries, newest first; an empty status matches all
+	FindByClient(ctx context.Context, clientID int64, status entity.WebhookDeliveryStatus, limit int) ([]*entity.WebhookDelivery, error)
+	// ClaimDue returns up to limit pending deliveries that are due and pushes their next attempt
+	// to now+lease, so concurrent workers do not pick them up while they are being sent
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.WebhookDelivery, error)
+}
@@ -1,27 +1,135 @@
 package service
 
 import (
+	"context"
 	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
 	"e-wallet/internal/domain/valueobject"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/utils"
+	"time"
 )
 
-type BalanceValidator struct{}
+// turnoverTypes are the transactions counted towards turnover limits: money entering the wallet
+var turnoverTypes = []entity.TransactionType{entity.TransactionTypeDeposit, entity.TransactionTypeTransferIn}
 
-func NewBalanceValidator() *BalanceValidator {
-	return &BalanceValidator{}
+// BalanceValidator is the limits engine: it checks the balance and turnover limits
+// of the wallet's tier before money is moved
+type BalanceValidator struct {
+	transactionRepo repository.TransactionRepository
+	location        *time.Location // business time zone for day and month boundaries
 }
 
-// ValidateDeposit validates if a deposit can be made to a wallet
-func (bv *BalanceValidator) ValidateDeposit(wallet *entity.Wallet, amount valueobject.Money) error {
-	return wallet.CanDeposit(amount)
+func NewBalanceValidator(transactionRepo repository.TransactionRepository, location *time.Location) *BalanceValidator {
+	return &BalanceValidator{
+		transactionRepo: transactionRepo,
+		location:        location,
+	}
+}
+
+// ValidateDeposit validates if a deposit can be made to a wallet.
+// Turnover is read from the transactions table, so ctx must carry the transaction that holds the wallet lock.
+func (bv *BalanceValidator) ValidateDeposit(ctx context.Context, wallet *entity.Wallet, amount valueobject.Money) error {
+	if err := wallet.CanDeposit(amount); err != nil {
+		return err
+	}
+
+	limits, err := wallet.Type.TurnoverLimits()
+	if err != nil {
+		return err
+	}
+	if limits.MaxOperation > 0 && amount.Amount() > limits.MaxOperation {
+		return apperrors.ErrOperationLimit
+	}
+	if limits.DailyCount == 0 && limits.DailyAmount == 0 && limits.MonthlyCount == 0 && limits.MonthlyAmount == 0 {
+		return nil
+	}
+
+	turnover, err := bv.Turnover(ctx, wallet)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case limits.DailyCount > 0 && turnover.DailyCount+1 > limits.DailyCount:
+		return apperrors.ErrDailyCountLimit
+	case limits.DailyAmount > 0 && turnover.DailyAmount+amount.Amount() > limits.DailyAmount:
+		return apperrors.ErrDailyAmountLimit
+	case limits.MonthlyCount > 0 && turnover.MonthlyCount+1 > limits.MonthlyCount:
+		return apperrors.ErrMonthlyCountLimit
+	case limits.MonthlyAmount > 0 && turnover.MonthlyAmount+amount.Amount() > limits.MonthlyAmount:
+		return apperrors.ErrMonthlyAmountLimit
+	}
+
+	return nil
 }
 
-// GetMaxAllowedDeposit calculates the maximum amount that can be deposited
-func (bv *BalanceValidator) GetMaxAllowedDeposit(wallet *entity.Wallet) (valueobject.Money, error) {
+// ValidateWithdrawal validates if a withdrawal can be made from a wallet
+func (bv *BalanceValidator) ValidateWithdrawal(wallet *entity.Wallet, amount valueobject.Money) error {
+	return wallet.CanWithdraw(amount)
+}
+
+// Turnover returns the wallet's incoming turnover for the current business day and month
+func (bv *BalanceValidator) Turnover(ctx context.Context, wallet *entity.Wallet) (*repository.Turnover, error) {
+	now := time.Now().In(bv.location)
+	dayStart, _ := utils.DayRange(now, now)
+	monthStart, _ := utils.MonthRange(now)
+
+	return bv.transactionRepo.GetTurnover(ctx, wallet.ID, turnoverTypes, dayStart, monthStart)
+}
+
+// DepositHeadroom describes how much more money a wallet can receive right now
+type DepositHeadroom struct {
+	MaxBalance valueobject.Money
+	MaxDeposit valueobject.Money // largest single deposit that passes every limit
+	Limits     valueobject.TurnoverLimits
+	Turnover   *repository.Turnover
+}
+
+// GetDepositHeadroom combines the balance limit, the tier's turnover limits and the
+// current turnover into the largest deposit the wallet accepts right now
+func (bv *BalanceValidator) GetDepositHeadroom(ctx context.Context, wallet *entity.Wallet) (*DepositHeadroom, error) {
 	maxBalance, err := wallet.Type.MaxBalance()
 	if err != nil {
-		return valueobject.Money{}, err
+		return nil, err
+	}
+	limits, err := wallet.Type.TurnoverLimits()
+	if err != nil {
+		return nil, err
+	}
+	turnover, err := bv.Turnover(ctx, wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDeposit := int64(0)
+	if wallet.CanCredit() == nil {
+		// A balance above the limit (e.g. after the tier was lowered) leaves no headroom
+		maxDeposit = max(maxBalance.Amount()-wallet.Balance.Amount(), 0)
+		if limits.MaxOperation > 0 {
+			maxDeposit = min(maxDeposit, limits.MaxOperation)
+		}
+		if limits.DailyAmount > 0 {
+			maxDeposit = min(maxDeposit, max(limits.DailyAmount-turnover.DailyAmount, 0))
+		}
+		if limits.MonthlyAmount > 0 {
+			maxDeposit = min(maxDeposit, max(limits.MonthlyAmount-turnover.MonthlyAmount, 0))
+		}
+		if (limits.DailyCount > 0 && turnover.DailyCount >= limits.DailyCount) ||
+			(limits.MonthlyCount > 0 && turnover.MonthlyCount >= limits.MonthlyCount) {
+			maxDeposit = 0
+		}
+	}
+
+	maxAllowed, err := valueobject.NewMoney(maxDeposit)
+	if err != nil {
+		return nil, err
 	}
 
-	return maxBalance.Subtract(wallet.Balance)
+	return &DepositHeadroom{
+		MaxBalance: maxBalance,
+		MaxDeposit: maxAllowed,
+		Limits:     limits,
+		Turnover:   turnover,
+	}, nil
 }
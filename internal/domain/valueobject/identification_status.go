@@ -0,0 +1,15 @@
+package valueobject
+
+// IdentificationStatus tracks a wallet's progress through KYC identification
+type IdentificationStatus string
+
+const (
+	IdentificationStatusNone       IdentificationStatus = "none"
+	IdentificationStatusPending    IdentificationStatus = "pending_identification"
+	IdentificationStatusIdentified IdentificationStatus = "identified"
+	IdentificationStatusRejected   IdentificationStatus = "rejected"
+)
+
+func (s IdentificationStatus) String() string {
+	return string(s)
+}
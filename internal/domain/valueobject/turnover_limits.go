@@ -0,0 +1,10 @@
+package valueobject
+
+// TurnoverLimits caps money entering a wallet. A zero field disables that limit.
+type TurnoverLimits struct {
+	MaxOperation  int64 // single operation (dirams)
+	DailyCount    int64 // operations per business day
+	DailyAmount   int64 // per business day (dirams)
+	MonthlyCount  int64 // operations per calendar month
+	MonthlyAmount int64 // per calendar month (dirams)
+}
@@ -0,0 +1,47 @@
+package valueobject
+
+import (
+	apperrors "e-wallet/pkg/errors"
+	"strings"
+)
+
+// WalletStatus is the lifecycle state of a wallet
+type WalletStatus string
+
+const (
+	WalletStatusActive  WalletStatus = "active"
+	WalletStatusFrozen  WalletStatus = "frozen"  // credits only
+	WalletStatusBlocked WalletStatus = "blocked" // no operations
+	WalletStatusClosed  WalletStatus = "closed"  // no operations, terminal
+)
+
+// walletStatusTransitions lists the statuses each status may move to
+var walletStatusTransitions = map[WalletStatus][]WalletStatus{
+	WalletStatusActive:  {WalletStatusFrozen, WalletStatusBlocked, WalletStatusClosed},
+	WalletStatusFrozen:  {WalletStatusActive, WalletStatusBlocked, WalletStatusClosed},
+	WalletStatusBlocked: {WalletStatusActive, WalletStatusFrozen, WalletStatusClosed},
+	WalletStatusClosed:  {},
+}
+
+func NewWalletStatus(value string) (WalletStatus, error) {
+	normalized := WalletStatus(strings.ToLower(strings.TrimSpace(value)))
+
+	if _, ok := walletStatusTransitions[normalized]; !ok {
+		return "", apperrors.ErrInvalidWalletStatus
+	}
+	return normalized, nil
+}
+
+func (ws WalletStatus) String() string {
+	return string(ws)
+}
+
+// CanTransitionTo reports whether the status may change to next
+func (ws WalletStatus) CanTransitionTo(next WalletStatus) bool {
+	for _, allowed := range walletStatusTransitions[ws] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package valueobject
+
+import (
+	"sort"
+	"sync"
+)
+
+// WalletTier defines the balance and turnover limits of a wallet type
+type WalletTier struct {
+	Type       WalletType
+	Name       string // display name, e.g. "Simplified identification"
+	MaxBalance Money
+	Limits     TurnoverLimits
+}
+
+// tierRegistry holds the wallet tiers known to the service. It starts with the
+// built-in tiers so the domain works before configuration is loaded.
+var tierRegistry = struct {
+	sync.RWMutex
+	tiers map[WalletType]WalletTier
+}{
+	tiers: map[WalletType]WalletTier{
+		WalletTypeIdentified: {
+			Type:       WalletTypeIdentified,
+			Name:       "Identified",
+			MaxBalance: Money{amount: MaxBalanceIdentified},
+		},
+		WalletTypeUnidentified: {
+			Type:       WalletTypeUnidentified,
+			Name:       "Unidentified",
+			MaxBalance: Money{amount: MaxBalanceUnidentified},
+		},
+	},
+}
+
+// RegisterWalletTiers replaces the registered tiers. The built-in identified and
+// unidentified tiers keep their defaults unless they are part of tiers.
+func RegisterWalletTiers(tiers []WalletTier) {
+	registered := map[WalletType]WalletTier{}
+
+	tierRegistry.Lock()
+	defer tierRegistry.Unlock()
+
+	for _, builtIn := range []WalletType{WalletTypeIdentified, WalletTypeUnidentified} {
+		registered[builtIn] = tierRegistry.tiers[builtIn]
+	}
+	for _, tier := range tiers {
+		registered[tier.Type] = tier
+	}
+
+	tierRegistry.tiers = registered
+}
+
+// LookupWalletTier returns the tier of a wallet type
+func LookupWalletTier(walletType WalletType) (WalletTier, bool) {
+	tierRegistry.RLock()
+	defer tierRegistry.RUnlock()
+
+	tier, ok := tierRegistry.tiers[walletType]
+	return tier, ok
+}
+
+// WalletTiers returns all registered tiers ordered by max balance
+func WalletTiers() []WalletTier {
+	tierRegistry.RLock()
+	defer tierRegistry.RUnlock()
+
+	tiers := make([]WalletTier, 0, len(tierRegistry.tiers))
+	for _, tier := range tierRegistry.tiers {
+		tiers = append(tiers, tier)
+	}
+	sort.Slice(tiers, func(i, j int) bool {
+		if tiers[i].MaxBalance.amount != tiers[j].MaxBalance.amount {
+			return tiers[i].MaxBalance.amount < tiers[j].MaxBalance.amount
+		}
+		return tiers[i].Type < tiers[j].Type
+	})
+	return tiers
+}
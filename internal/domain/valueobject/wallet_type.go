@@ -5,6 +5,8 @@ import (
 	"strings"
 )
 
+// WalletType names a wallet tier. Besides the two built-in tiers, any tier
+// registered from configuration is a valid wallet type.
 type WalletType string
 
 const (
@@ -12,6 +14,7 @@ const (
 	WalletTypeUnidentified WalletType = "unidentified"
 )
 
+// Default balance limits of the built-in tiers, used unless configured otherwise
 const (
 	MaxBalanceIdentified   = 10000000 // 100,000 TJS in dirams
 	MaxBalanceUnidentified = 1000000  // 10,000 TJS in dirams
@@ -20,12 +23,10 @@ const (
 func NewWalletType(value string) (WalletType, error) {
 	normalized := WalletType(strings.ToLower(strings.TrimSpace(value)))
 
-	switch normalized {
-	case WalletTypeIdentified, WalletTypeUnidentified:
-		return normalized, nil
-	default:
+	if _, ok := LookupWalletTier(normalized); !ok {
 		return "", apperrors.ErrInvalidWalletType
 	}
+	return normalized, nil
 }
 
 func (wt WalletType) String() string {
@@ -35,13 +36,27 @@ func (wt WalletType) String() string {
 func (wt WalletType) IsIdentified() bool {
 	return wt == WalletTypeIdentified
 }
+
+// RequiresIdentification reports whether a wallet may only reach the tier through approved identification.
+// Every tier except unidentified does.
+func (wt WalletType) RequiresIdentification() bool {
+	return wt != WalletTypeUnidentified
+}
+
+// MaxBalance returns the balance limit of the wallet's tier
 func (wt WalletType) MaxBalance() (Money, error) {
-	switch wt {
-	case WalletTypeIdentified:
-		return NewMoney(MaxBalanceIdentified)
-	case WalletTypeUnidentified:
-		return NewMoney(MaxBalanceUnidentified)
-	default:
+	tier, ok := LookupWalletTier(wt)
+	if !ok {
 		return Money{}, apperrors.ErrInvalidWalletType
 	}
+	return tier.MaxBalance, nil
+}
+
+// TurnoverLimits returns the turnover limits of the wallet's tier
+func (wt WalletType) TurnoverLimits() (TurnoverLimits, error) {
+	tier, ok := LookupWalletTier(wt)
+	if !ok {
+		return TurnoverLimits{}, apperrors.ErrInvalidWalletType
+	}
+	return tier.Limits, nil
 }
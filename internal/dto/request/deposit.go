@@ -5,4 +5,13 @@ package request
 type DepositRequest struct {
 	AccountID string `json:"account_id" validate:"required,min=3,max=50"`
 	Amount    int64  `json:"amount" validate:"required,gt=0"`
+
+	// Optional partner data stored on the transaction; ExternalID must be unique per client
+	ExternalID  string            `json:"external_id" validate:"omitempty,max=64,printascii"`
+	Description string            `json:"description" validate:"omitempty,max=255"`
+	Metadata    map[string]string `json:"metadata" validate:"omitempty,max=20,dive,keys,min=1,max=40,endkeys,max=500"`
+
+	// Set by the handler from the authenticated client and the Idempotency-Key header
+	ClientID       int64  `json:"-"`
+	IdempotencyKey string `json:"-" validate:"omitempty,max=255"`
 }
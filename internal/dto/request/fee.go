@@ -0,0 +1,31 @@
+package request
+
+import "time"
+
+// FeeRuleRequest describes one rule of a fee schedule; amounts are in dirams
+type FeeRuleRequest struct {
+	Operation  string `json:"operation" validate:"required,oneof=deposit withdrawal transfer"`
+	WalletType string `json:"wallet_type" validate:"omitempty,max=50"` // empty matches every wallet type
+	MinAmount  int64  `json:"min_amount" validate:"gte=0"`
+	MaxAmount  int64  `json:"max_amount" validate:"gte=0"` // exclusive; 0 means no upper bound
+	FixedFee   int64  `json:"fixed_fee" validate:"gte=0"`
+	PercentBps int64  `json:"percent_bps" validate:"gte=0,lte=10000"` // basis points, 100 = 1%
+	MinFee     int64  `json:"min_fee" validate:"gte=0"`
+	MaxFee     int64  `json:"max_fee" validate:"gte=0"` // 0 means no cap
+}
+
+// CreateFeeScheduleRequest represents an admin request to add a fee schedule version.
+// An empty UserID targets the default schedule; EffectiveFrom defaults to now and cannot be in the past.
+type CreateFeeScheduleRequest struct {
+	UserID        string           `json:"user_id" validate:"omitempty,min=3,max=50"`
+	EffectiveFrom *time.Time       `json:"effective_from"`
+	Rules         []FeeRuleRequest `json:"rules" validate:"max=50,dive"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated admin client
+}
+
+// ListFeeSchedulesRequest represents an admin request to list the fee schedule versions of a partner,
+// or of the default schedule when UserID is empty
+type ListFeeSchedulesRequest struct {
+	UserID string `json:"user_id" validate:"omitempty,min=3,max=50"`
+}
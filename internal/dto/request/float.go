@@ -0,0 +1,19 @@
+package request
+
+// FloatStatementRequest represents the request to get the partner's float balance and movements
+type FloatStatementRequest struct {
+	Limit  int    `json:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `json:"cursor" validate:"omitempty,max=200"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// TopUpFloatRequest represents an admin request to credit a partner's float
+// after the partner's settlement transfer has arrived; Amount is in dirams
+type TopUpFloatRequest struct {
+	UserID    string `json:"user_id" validate:"required,min=3,max=50"`
+	Amount    int64  `json:"amount" validate:"required,gt=0"`
+	Reference string `json:"reference" validate:"required,min=3,max=64"` // bank transfer reference, applied at most once
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated admin client
+}
@@ -0,0 +1,30 @@
+package request
+
+// AuthorizeHoldRequest represents the request to reserve money on a wallet
+// Amount is in dirams (1 TJS = 100 dirams)
+type AuthorizeHoldRequest struct {
+	AccountID string `json:"account_id" validate:"required,min=3,max=50"`
+	Amount    int64  `json:"amount" validate:"required,gt=0"`
+
+	// Set by the handler from the authenticated client and the Idempotency-Key header
+	ClientID       int64  `json:"-"`
+	IdempotencyKey string `json:"-" validate:"omitempty,max=255"`
+}
+
+// CaptureHoldRequest represents the request to capture a hold
+// Amount is in dirams; when omitted the full held amount is captured
+type CaptureHoldRequest struct {
+	HoldID int64 `json:"hold_id" validate:"required,gt=0"`
+	Amount int64 `json:"amount" validate:"omitempty,gt=0"`
+
+	// Set by the handler from the authenticated client and the Idempotency-Key header
+	ClientID       int64  `json:"-"`
+	IdempotencyKey string `json:"-" validate:"omitempty,max=255"`
+}
+
+// VoidHoldRequest represents the request to release a hold without capturing it
+type VoidHoldRequest struct {
+	HoldID int64 `json:"hold_id" validate:"required,gt=0"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
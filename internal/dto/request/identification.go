@@ -0,0 +1,30 @@
+package request
+
+// IdentifyWalletRequest represents a partner's KYC submission for a wallet
+type IdentifyWalletRequest struct {
+	AccountID      string `json:"account_id" validate:"required,min=3,max=50"`
+	FullName       string `json:"full_name" validate:"required,min=3,max=200"`
+	DocumentType   string `json:"document_type" validate:"required,oneof=passport national_id residence_permit"`
+	DocumentNumber string `json:"document_number" validate:"required,alphanum,min=4,max=50"`
+	DateOfBirth    string `json:"date_of_birth" validate:"required,datetime=2006-01-02"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// ReviewIdentificationRequest represents an admin decision on a pending identification
+type ReviewIdentificationRequest struct {
+	AccountID  string `json:"account_id" validate:"required,min=3,max=50"`
+	Reason     string `json:"reason" validate:"max=500"`                 // required when rejecting
+	WalletType string `json:"wallet_type" validate:"omitempty,max=20"`   // tier granted on approval, default identified
+	UserID     string `json:"user_id" validate:"omitempty,min=3,max=50"` // owning partner, needed when several partners use the account ID
+
+	// Set by the handler from the route and the authenticated admin client
+	Approve  bool  `json:"-"`
+	ClientID int64 `json:"-"`
+}
+
+// IdentificationHistoryRequest represents the request to get a wallet's identification audit trail
+type IdentificationHistoryRequest struct {
+	AccountID string `json:"account_id" validate:"required,min=3,max=50"`
+	UserID    string `json:"user_id" validate:"omitempty,min=3,max=50"` // owning partner, needed when several partners use the account ID
+}
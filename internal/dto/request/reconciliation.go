@@ -0,0 +1,6 @@
+package request
+
+// RunReconciliationRequest represents the request to reconcile all wallet balances
+type RunReconciliationRequest struct {
+	ClientID int64 `json:"-"` // set by the handler from the authenticated admin client; 0 from the command line
+}
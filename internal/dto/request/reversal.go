@@ -0,0 +1,13 @@
+package request
+
+// ReverseTransactionRequest represents the request to reverse a deposit or withdrawal
+// Amount is in dirams (1 TJS = 100 dirams); when omitted whatever has not been reversed yet is reversed.
+// A transaction can be reversed several times, so the Idempotency-Key header is required to make retries safe.
+type ReverseTransactionRequest struct {
+	TransactionID int64 `json:"transaction_id" validate:"required,gt=0"`
+	Amount        int64 `json:"amount" validate:"omitempty,gt=0"`
+
+	// Set by the handler from the authenticated client and the Idempotency-Key header
+	ClientID       int64  `json:"-"`
+	IdempotencyKey string `json:"-" validate:"omitempty,max=255"`
+}
@@ -0,0 +1,17 @@
+package request
+
+// ListSettlementsRequest represents the request to get the partner's daily settlements
+// for the business dates from..to inclusive (YYYY-MM-DD, at most 92 days)
+type ListSettlementsRequest struct {
+	From string `json:"from" validate:"required,datetime=2006-01-02"`
+	To   string `json:"to" validate:"required,datetime=2006-01-02"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// RunSettlementRequest represents an admin request to settle one ended business day (YYYY-MM-DD)
+type RunSettlementRequest struct {
+	BusinessDate string `json:"business_date" validate:"required,datetime=2006-01-02"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated admin client
+}
@@ -0,0 +1,36 @@
+package request
+
+import "time"
+
+// GetTransactionsRequest represents the request to list wallet transactions
+// From/To are RFC 3339 timestamps (To is exclusive); amounts are in dirams
+type GetTransactionsRequest struct {
+	AccountID string     `json:"account_id" validate:"required,min=3,max=50"`
+	Types     []string   `json:"types" validate:"omitempty,max=10,dive,required"`
+	From      *time.Time `json:"from"`
+	To        *time.Time `json:"to"`
+	MinAmount *int64     `json:"min_amount" validate:"omitempty,gte=0"`
+	MaxAmount *int64     `json:"max_amount" validate:"omitempty,gte=0"`
+	Limit     int        `json:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor    string     `json:"cursor" validate:"omitempty,max=200"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// GetTransactionRequest represents the request to look up one transaction
+// by our transaction ID or by the external ID the partner sent with it; exactly one must be given
+type GetTransactionRequest struct {
+	TransactionID int64  `json:"transaction_id" validate:"required_without=ExternalID,excluded_with=ExternalID,omitempty,gt=0"`
+	ExternalID    string `json:"external_id" validate:"required_without=TransactionID,omitempty,max=64"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// GetTransactionStatusRequest represents the request to check whether an operation went through,
+// by our transaction ID or by the partner's external ID; exactly one must be given
+type GetTransactionStatusRequest struct {
+	TransactionID int64  `json:"transaction_id" validate:"required_without=ExternalID,excluded_with=ExternalID,omitempty,gt=0"`
+	ExternalID    string `json:"external_id" validate:"required_without=TransactionID,omitempty,max=64"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
@@ -0,0 +1,13 @@
+package request
+
+// TransferRequest represents the request to move money between two wallets
+// Amount is in dirams (1 TJS = 100 dirams)
+type TransferRequest struct {
+	FromAccountID string `json:"from_account_id" validate:"required,min=3,max=50"`
+	ToAccountID   string `json:"to_account_id" validate:"required,min=3,max=50"`
+	Amount        int64  `json:"amount" validate:"required,gt=0"`
+
+	// Set by the handler from the authenticated client and the Idempotency-Key header
+	ClientID       int64  `json:"-"`
+	IdempotencyKey string `json:"-" validate:"omitempty,max=255"`
+}
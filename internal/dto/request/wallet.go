@@ -3,14 +3,42 @@ package request
 // CheckWalletRequest represents the request to check if a wallet exists
 type CheckWalletRequest struct {
 	AccountID string `json:"account_id" validate:"required,min=3,max=50"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
 }
 
 // GetBalanceRequest represents the request to get wallet balance
 type GetBalanceRequest struct {
 	AccountID string `json:"account_id" validate:"required,min=3,max=50"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
 }
 
-// GetMonthlyStatsRequest represents the request to get monthly statistics
+// GetMonthlyStatsRequest represents the request to get wallet statistics for a period
+// Pass either Month (YYYY-MM) or From/To (YYYY-MM-DD, both inclusive); defaults to the current month
 type GetMonthlyStatsRequest struct {
 	AccountID string `json:"account_id" validate:"required,min=3,max=50"`
+	Month     string `json:"month" validate:"omitempty,datetime=2006-01,excluded_with=From To"`
+	From      string `json:"from" validate:"omitempty,datetime=2006-01-02,required_with=To"`
+	To        string `json:"to" validate:"omitempty,datetime=2006-01-02,required_with=From"`
+	GroupBy   string `json:"group_by" validate:"omitempty,oneof=day week"` // breakdown bucket, default day
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// CreateWalletRequest represents the request to open a new wallet.
+// WalletType is optional and defaults to unidentified, the only accepted value: any other tier is
+// rejected with IDENTIFICATION_REQUIRED, since higher tiers are granted only by an approved identification.
+type CreateWalletRequest struct {
+	AccountID  string `json:"account_id" validate:"required,min=3,max=50"`
+	WalletType string `json:"wallet_type" validate:"omitempty,max=20"` // unidentified or empty; anything else is rejected
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// GetLimitsRequest represents the request to get a wallet's remaining limits
+type GetLimitsRequest struct {
+	AccountID string `json:"account_id" validate:"required,min=3,max=50"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
 }
@@ -0,0 +1,17 @@
+package request
+
+// ChangeWalletStatusRequest represents an admin request to change a wallet's lifecycle status
+type ChangeWalletStatusRequest struct {
+	AccountID string `json:"account_id" validate:"required,min=3,max=50"`
+	Status    string `json:"status" validate:"required,oneof=active frozen blocked closed"`
+	Reason    string `json:"reason" validate:"required,min=3,max=500"`
+	UserID    string `json:"user_id" validate:"omitempty,min=3,max=50"` // owning partner, needed when several partners use the account ID
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated admin client
+}
+
+// WalletStatusHistoryRequest represents the request to get a wallet's status audit trail
+type WalletStatusHistoryRequest struct {
+	AccountID string `json:"account_id" validate:"required,min=3,max=50"`
+	UserID    string `json:"user_id" validate:"omitempty,min=3,max=50"` // owning partner, needed when several partners use the account ID
+}
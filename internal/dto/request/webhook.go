@@ -0,0 +1,37 @@
+package request
+
+// CreateWebhookSubscriptionRequest represents the request to subscribe an endpoint to events
+// An empty EventTypes list subscribes to every event type
+type CreateWebhookSubscriptionRequest struct {
+	URL        string   `json:"url" validate:"required,url,max=500"`
+	EventTypes []string `json:"event_types" validate:"omitempty,max=10,dive,required"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// DeleteWebhookSubscriptionRequest represents the request to remove a webhook subscription
+type DeleteWebhookSubscriptionRequest struct {
+	SubscriptionID int64 `json:"subscription_id" validate:"required,gt=0"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// ListWebhookSubscriptionsRequest represents the request to list the client's webhook subscriptions
+type ListWebhookSubscriptionsRequest struct {
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// ListWebhookDeliveriesRequest represents the request to list the client's latest webhook deliveries
+type ListWebhookDeliveriesRequest struct {
+	Status string `json:"status" validate:"omitempty,oneof=pending delivered dead"`
+	Limit  int    `json:"limit" validate:"omitempty,min=1,max=100"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
+
+// RedeliverWebhookRequest represents the request to queue a delivered or dead webhook again
+type RedeliverWebhookRequest struct {
+	DeliveryID int64 `json:"delivery_id" validate:"required,gt=0"`
+
+	ClientID int64 `json:"-"` // set by the handler from the authenticated client
+}
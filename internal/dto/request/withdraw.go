@@ -0,0 +1,12 @@
+package request
+
+// WithdrawRequest represents the request to withdraw money from a wallet
+// Amount is in dirams (1 TJS = 100 dirams)
+type WithdrawRequest struct {
+	AccountID string `json:"account_id" validate:"required,min=3,max=50"`
+	Amount    int64  `json:"amount" validate:"required,gt=0"`
+
+	// Set by the handler from the authenticated client and the Idempotency-Key header
+	ClientID       int64  `json:"-"`
+	IdempotencyKey string `json:"-" validate:"omitempty,max=255"`
+}
@@ -1,12 +1,16 @@
 package response
 
 // DepositResponse represents the response for a deposit operation
-// Amount and NewBalance are in dirams (1 TJS = 100 dirams)
+// Amounts are in dirams (1 TJS = 100 dirams). The wallet receives the full Amount; the Fee is the partner's
+// commission, drawn from its float, and NetAmount is the Amount net of it.
 type DepositResponse struct {
-	Success       bool   `json:"success"`
-	AccountID     string `json:"account_id"`
-	Amount        int64  `json:"amount"`
-	NewBalance    int64  `json:"new_balance"`
-	Currency      string `json:"currency"`
-	TransactionID int64  `json:"transaction_id"`
+	Success          bool   `json:"success"`
+	AccountID        string `json:"account_id"`
+	Amount           int64  `json:"amount"`
+	Fee              int64  `json:"fee"`
+	NetAmount        int64  `json:"net_amount"`
+	NewBalance       int64  `json:"new_balance"`
+	Currency         string `json:"currency"`
+	TransactionID    int64  `json:"transaction_id"`
+	FeeTransactionID *int64 `json:"fee_transaction_id,omitempty"`
 }
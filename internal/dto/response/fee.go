@@ -0,0 +1,37 @@
+package response
+
+import "time"
+
+// FeeRuleItem represents one rule of a fee schedule; amounts are in dirams
+type FeeRuleItem struct {
+	ID         int64  `json:"id"`
+	Operation  string `json:"operation"`
+	WalletType string `json:"wallet_type,omitempty"`
+	MinAmount  int64  `json:"min_amount"`
+	MaxAmount  int64  `json:"max_amount"` // 0 means no upper bound
+	FixedFee   int64  `json:"fixed_fee"`
+	PercentBps int64  `json:"percent_bps"`
+	MinFee     int64  `json:"min_fee"`
+	MaxFee     int64  `json:"max_fee"` // 0 means no cap
+}
+
+// FeeScheduleItem represents one version of a fee schedule
+type FeeScheduleItem struct {
+	ID            int64         `json:"id"`
+	UserID        string        `json:"user_id,omitempty"` // empty for the default schedule
+	EffectiveFrom time.Time     `json:"effective_from"`
+	Rules         []FeeRuleItem `json:"rules"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// FeeScheduleResponse represents the result of adding a fee schedule version
+type FeeScheduleResponse struct {
+	Success  bool            `json:"success"`
+	Schedule FeeScheduleItem `json:"schedule"`
+}
+
+// FeeSchedulesResponse represents every version of a fee schedule, newest first
+type FeeSchedulesResponse struct {
+	UserID    string            `json:"user_id,omitempty"`
+	Schedules []FeeScheduleItem `json:"schedules"`
+}
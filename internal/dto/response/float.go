@@ -0,0 +1,34 @@
+package response
+
+import "time"
+
+// FloatMovementItem represents one movement of a partner float
+// Credits (top-ups, withdrawals, deposit reversals) increase the float, debits (deposits) draw it down
+type FloatMovementItem struct {
+	ID        int64     `json:"id"`
+	Kind      string    `json:"kind"`      // deposit, withdrawal, reversal, hold_capture or float_top_up
+	Direction string    `json:"direction"` // credit or debit
+	Amount    int64     `json:"amount"`    // in dirams
+	Reference string    `json:"reference"` // transaction reference, or the bank reference of a top-up
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FloatStatementResponse represents the partner's float balance and one page of its movements, newest first
+// Pass NextCursor as cursor to fetch the following page
+type FloatStatementResponse struct {
+	Balance    int64               `json:"balance"` // in dirams
+	Currency   string              `json:"currency"`
+	Movements  []FloatMovementItem `json:"movements"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+	HasMore    bool                `json:"has_more"`
+}
+
+// TopUpFloatResponse represents the result of a float top-up
+type TopUpFloatResponse struct {
+	Success    bool   `json:"success"`
+	UserID     string `json:"user_id"`
+	Amount     int64  `json:"amount"`      // in dirams
+	NewBalance int64  `json:"new_balance"` // in dirams
+	Currency   string `json:"currency"`
+	Reference  string `json:"reference"`
+}
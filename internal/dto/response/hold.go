@@ -0,0 +1,19 @@
+package response
+
+import "time"
+
+// HoldResponse represents a hold and the wallet balances after the operation
+// Amounts are in dirams (1 TJS = 100 dirams)
+type HoldResponse struct {
+	Success          bool      `json:"success"`
+	HoldID           int64     `json:"hold_id"`
+	AccountID        string    `json:"account_id"`
+	Status           string    `json:"status"` // active, captured, voided or expired
+	Amount           int64     `json:"amount"`
+	CapturedAmount   int64     `json:"captured_amount"`
+	Balance          int64     `json:"balance"`
+	AvailableBalance int64     `json:"available_balance"`
+	Currency         string    `json:"currency"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	TransactionID    int64     `json:"transaction_id,omitempty"` // the capture transaction
+}
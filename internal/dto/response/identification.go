@@ -0,0 +1,39 @@
+package response
+
+import "time"
+
+// IdentifyWalletResponse represents the response for a KYC submission
+type IdentifyWalletResponse struct {
+	Success              bool   `json:"success"`
+	AccountID            string `json:"account_id"`
+	IdentificationStatus string `json:"identification_status"`
+	SubmissionID         int64  `json:"submission_id"`
+}
+
+// ReviewIdentificationResponse represents the response for an identification approval or rejection
+// MaxBalance is in dirams (1 TJS = 100 dirams)
+type ReviewIdentificationResponse struct {
+	Success              bool   `json:"success"`
+	AccountID            string `json:"account_id"`
+	WalletType           string `json:"wallet_type"`
+	IdentificationStatus string `json:"identification_status"`
+	MaxBalance           int64  `json:"max_balance"`
+}
+
+// IdentificationEventItem represents one identification status change
+type IdentificationEventItem struct {
+	SubmissionID  int64     `json:"submission_id"`
+	FromStatus    string    `json:"from_status"`
+	ToStatus      string    `json:"to_status"`
+	ActorClientID int64     `json:"actor_client_id"`
+	Reason        string    `json:"reason,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// IdentificationHistoryResponse represents a wallet's identification audit trail, oldest first
+type IdentificationHistoryResponse struct {
+	AccountID            string                    `json:"account_id"`
+	WalletType           string                    `json:"wallet_type"`
+	IdentificationStatus string                    `json:"identification_status"`
+	Events               []IdentificationEventItem `json:"events"`
+}
@@ -0,0 +1,25 @@
+package response
+
+// LedgerTypeBalanceItem represents the summed balance of one ledger account type
+type LedgerTypeBalanceItem struct {
+	Type     string `json:"type"`
+	Accounts int64  `json:"accounts"`
+	Balance  int64  `json:"balance"` // in dirams, credits minus debits
+}
+
+// LedgerAccountItem represents a ledger account
+type LedgerAccountItem struct {
+	Code    string `json:"code"`
+	Type    string `json:"type"`
+	Balance int64  `json:"balance"` // in dirams
+}
+
+// LedgerTrialBalanceResponse represents the ledger trial balance.
+// Total must be zero because every debit has an equal credit.
+type LedgerTrialBalanceResponse struct {
+	Balanced        bool                    `json:"balanced"`
+	Total           int64                   `json:"total"`
+	Currency        string                  `json:"currency"`
+	ByType          []LedgerTypeBalanceItem `json:"by_type"`
+	DriftedAccounts []LedgerAccountItem     `json:"drifted_accounts"` // stored balance differs from the sum of postings
+}
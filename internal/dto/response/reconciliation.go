@@ -0,0 +1,24 @@
+package response
+
+import "time"
+
+// ReconciliationDiscrepancyItem represents a wallet whose stored balance differs from its transactions
+type ReconciliationDiscrepancyItem struct {
+	AccountID       string `json:"account_id"`
+	StoredBalance   int64  `json:"stored_balance"`   // wallets.balance in dirams
+	ComputedBalance int64  `json:"computed_balance"` // recomputed from transactions in dirams
+	Difference      int64  `json:"difference"`       // stored - computed in dirams
+}
+
+// ReconciliationResponse represents the summary of a reconciliation run
+type ReconciliationResponse struct {
+	RunID          int64                           `json:"run_id"`
+	Balanced       bool                            `json:"balanced"`
+	WalletsChecked int64                           `json:"wallets_checked"`
+	TotalStored    int64                           `json:"total_stored"`
+	TotalComputed  int64                           `json:"total_computed"`
+	Currency       string                          `json:"currency"`
+	Discrepancies  []ReconciliationDiscrepancyItem `json:"discrepancies"`
+	StartedAt      time.Time                       `json:"started_at"`
+	FinishedAt     time.Time                       `json:"finished_at"`
+}
@@ -0,0 +1,17 @@
+package response
+
+// ReverseTransactionResponse represents the response for a reversal
+// Amounts and NewBalance are in dirams (1 TJS = 100 dirams); FeeRefund is the share of the original's fee returned
+type ReverseTransactionResponse struct {
+	Success                bool   `json:"success"`
+	TransactionID          int64  `json:"transaction_id"` // the compensating transaction
+	OriginalTransactionID  int64  `json:"original_transaction_id"`
+	AccountID              string `json:"account_id"`
+	Type                   string `json:"type"`
+	Amount                 int64  `json:"amount"`
+	ReversedAmount         int64  `json:"reversed_amount"` // of the original in total, this reversal included
+	FeeRefund              int64  `json:"fee_refund"`
+	NewBalance             int64  `json:"new_balance"`
+	Currency               string `json:"currency"`
+	FeeRefundTransactionID *int64 `json:"fee_refund_transaction_id,omitempty"`
+}
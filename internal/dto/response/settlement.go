@@ -0,0 +1,50 @@
+package response
+
+import "time"
+
+// SettlementItem represents one partner's settlement for one business day; amounts are in dirams
+// NetAmount is owed by the partner when positive and owed to it when negative, fees net of refunds included
+type SettlementItem struct {
+	UserID                   string    `json:"user_id,omitempty"` // set in admin responses
+	BusinessDate             string    `json:"business_date"`
+	DepositCount             int64     `json:"deposit_count"`
+	DepositAmount            int64     `json:"deposit_amount"`
+	DepositReversalCount     int64     `json:"deposit_reversal_count"`
+	DepositReversalAmount    int64     `json:"deposit_reversal_amount"`
+	WithdrawalCount          int64     `json:"withdrawal_count"`
+	WithdrawalAmount         int64     `json:"withdrawal_amount"`
+	WithdrawalReversalCount  int64     `json:"withdrawal_reversal_count"`
+	WithdrawalReversalAmount int64     `json:"withdrawal_reversal_amount"`
+	CaptureCount             int64     `json:"capture_count"`
+	CaptureAmount            int64     `json:"capture_amount"`
+	FeeCount                 int64     `json:"fee_count"`
+	FeeAmount                int64     `json:"fee_amount"`
+	FeeRefundCount           int64     `json:"fee_refund_count"`
+	FeeRefundAmount          int64     `json:"fee_refund_amount"`
+	NetAmount                int64     `json:"net_amount"`
+	GeneratedAt              time.Time `json:"generated_at"`
+}
+
+// SettlementsResponse represents the partner's settlements for a date range, oldest first
+// Days that have not been settled yet are missing
+type SettlementsResponse struct {
+	From           string           `json:"from"`
+	To             string           `json:"to"`
+	Currency       string           `json:"currency"`
+	TotalNetAmount int64            `json:"total_net_amount"` // in dirams
+	Settlements    []SettlementItem `json:"settlements"`
+}
+
+// RunSettlementResponse represents the settlements of every partner for one business day
+type RunSettlementResponse struct {
+	BusinessDate string           `json:"business_date"`
+	Created      int64            `json:"created"` // settlements created by this run; 0 when the day was already settled
+	Currency     string           `json:"currency"`
+	Settlements  []SettlementItem `json:"settlements"`
+}
+
+// SettlementExport is a settlements report rendered as a CSV file
+type SettlementExport struct {
+	FileName string
+	Content  []byte
+}
@@ -1,11 +1,31 @@
 package response
 
-// MonthlyStatsResponse represents the response for monthly statistics
-// TotalAmount is in dirams (1 TJS = 100 dirams)
+// MonthlyStatsResponse represents the response for wallet statistics over a period
+// TotalCount and TotalAmount cover deposits only, as before; ByType and Breakdown cover all transaction types
+// All amounts are in dirams (1 TJS = 100 dirams)
 type MonthlyStatsResponse struct {
 	AccountID   string `json:"account_id"`
-	Month       string `json:"month"`
+	Month       string `json:"month,omitempty"` // set when the period is a calendar month
 	TotalCount  int64  `json:"total_count"`
 	TotalAmount int64  `json:"total_amount"`
 	Currency    string `json:"currency"`
+
+	From      string        `json:"from"` // first day of the period (YYYY-MM-DD)
+	To        string        `json:"to"`   // last day of the period, inclusive
+	GroupBy   string        `json:"group_by"`
+	ByType    []TypeStats   `json:"by_type"`
+	Breakdown []PeriodStats `json:"breakdown"` // only buckets with transactions
+}
+
+// TypeStats represents the count and sum of one transaction type
+type TypeStats struct {
+	Type   string `json:"type"`
+	Count  int64  `json:"count"`
+	Amount int64  `json:"amount"`
+}
+
+// PeriodStats represents one day or week of a statistics breakdown
+type PeriodStats struct {
+	Period string      `json:"period"` // first day of the bucket within the requested range (YYYY-MM-DD)
+	ByType []TypeStats `json:"by_type"`
 }
@@ -0,0 +1,72 @@
+package response
+
+import "time"
+
+// TransactionItem represents a single wallet transaction
+// Amount and BalanceAfter are in dirams (1 TJS = 100 dirams)
+type TransactionItem struct {
+	ID           int64             `json:"id"`
+	Type         string            `json:"type"`
+	Amount       int64             `json:"amount"`
+	BalanceAfter *int64            `json:"balance_after"` // null for transactions recorded before balances were tracked
+	Reference    string            `json:"reference,omitempty"`
+	FeeRuleID    *int64            `json:"fee_rule_id,omitempty"` // set on fees: the rule of the fee schedule version that priced it
+	ExternalID   string            `json:"external_id,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	Status       string            `json:"status"`
+	CreatedAt    time.Time         `json:"created_at"`
+}
+
+// TransactionHistoryResponse represents one page of wallet transactions, newest first
+// Pass NextCursor as cursor to fetch the following page
+type TransactionHistoryResponse struct {
+	AccountID    string            `json:"account_id"`
+	Currency     string            `json:"currency"`
+	Transactions []TransactionItem `json:"transactions"`
+	NextCursor   string            `json:"next_cursor,omitempty"`
+	HasMore      bool              `json:"has_more"`
+}
+
+// TransactionResponse represents a single transaction looked up by its ID or the partner's external ID
+// Amount and BalanceAfter are in dirams (1 TJS = 100 dirams)
+type TransactionResponse struct {
+	ID                    int64             `json:"id"`
+	AccountID             string            `json:"account_id"`
+	Type                  string            `json:"type"`
+	Amount                int64             `json:"amount"`
+	BalanceAfter          *int64            `json:"balance_after"` // null for transactions recorded before balances were tracked
+	Currency              string            `json:"currency"`
+	Reference             string            `json:"reference,omitempty"`
+	ExternalID            string            `json:"external_id,omitempty"`
+	Description           string            `json:"description,omitempty"`
+	Metadata              map[string]string `json:"metadata,omitempty"`
+	OriginalTransactionID *int64            `json:"original_transaction_id,omitempty"` // set on reversals
+	FeeRuleID             *int64            `json:"fee_rule_id,omitempty"`             // set on fees
+	Status                string            `json:"status"`
+	CreatedAt             time.Time         `json:"created_at"`
+	UpdatedAt             time.Time         `json:"updated_at"`
+}
+
+// TransactionStatusResponse represents the processing state of a single transaction
+// Status is pending, completed, failed or reversed; amounts are in dirams
+type TransactionStatusResponse struct {
+	TransactionID  int64                     `json:"transaction_id"`
+	ExternalID     string                    `json:"external_id,omitempty"`
+	AccountID      string                    `json:"account_id"`
+	Type           string                    `json:"type"`
+	Status         string                    `json:"status"`
+	Amount         int64                     `json:"amount"`
+	ReversedAmount int64                     `json:"reversed_amount"` // reversed so far, at most amount
+	Currency       string                    `json:"currency"`
+	Reversals      []TransactionReversalItem `json:"reversals,omitempty"` // set when the status is reversed, oldest first
+	CreatedAt      time.Time                 `json:"created_at"`
+	UpdatedAt      time.Time                 `json:"updated_at"`
+}
+
+// TransactionReversalItem represents a reversal that compensated all or part of a transaction
+type TransactionReversalItem struct {
+	TransactionID int64     `json:"transaction_id"`
+	Amount        int64     `json:"amount"` // in dirams
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,20 @@
+package response
+
+// TransferResponse represents the response for a wallet-to-wallet transfer
+// Amounts are in dirams (1 TJS = 100 dirams); NewBalance is the source wallet balance.
+// The source wallet pays only the Amount; the Fee is the partner's commission, drawn from its float,
+// and NetAmount is the Amount net of it.
+type TransferResponse struct {
+	Success          bool   `json:"success"`
+	FromAccountID    string `json:"from_account_id"`
+	ToAccountID      string `json:"to_account_id"`
+	Amount           int64  `json:"amount"`
+	Fee              int64  `json:"fee"`
+	NetAmount        int64  `json:"net_amount"`
+	NewBalance       int64  `json:"new_balance"`
+	Currency         string `json:"currency"`
+	Reference        string `json:"reference"`
+	OutTransactionID int64  `json:"out_transaction_id"`
+	InTransactionID  int64  `json:"in_transaction_id"`
+	FeeTransactionID *int64 `json:"fee_transaction_id,omitempty"`
+}
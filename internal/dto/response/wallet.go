@@ -1,15 +1,60 @@
 package response
 
+import "time"
+
 // CheckWalletResponse represents the response for wallet existence check
 type CheckWalletResponse struct {
 	Exists    bool   `json:"exists"`
 	AccountID string `json:"account_id,omitempty"`
+	Status    string `json:"status,omitempty"` // active, frozen, blocked or closed
 }
 
 // GetBalanceResponse represents the response for wallet balance
-// Balance is in dirams (1 TJS = 100 dirams)
+// Balances are in dirams (1 TJS = 100 dirams); AvailableBalance is Balance minus active holds
 type GetBalanceResponse struct {
-	AccountID string `json:"account_id"`
-	Balance   int64  `json:"balance"`
-	Currency  string `json:"currency"`
+	AccountID        string `json:"account_id"`
+	Balance          int64  `json:"balance"`
+	AvailableBalance int64  `json:"available_balance"`
+	HeldAmount       int64  `json:"held_amount"`
+	Currency         string `json:"currency"`
+
+	Status               string `json:"status"`
+	WalletType           string `json:"wallet_type"`
+	IdentificationStatus string `json:"identification_status"`
+}
+
+// CreateWalletResponse represents the response for wallet creation
+// Balance is in dirams (1 TJS = 100 dirams)
+type CreateWalletResponse struct {
+	Success    bool      `json:"success"`
+	AccountID  string    `json:"account_id"`
+	WalletType string    `json:"wallet_type"`
+	Balance    int64     `json:"balance"`
+	Currency   string    `json:"currency"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TurnoverHeadroom represents used and remaining incoming turnover for one period.
+// Limits of 0 are not enforced and their remaining values are null.
+type TurnoverHeadroom struct {
+	Count           int64  `json:"count"`
+	Amount          int64  `json:"amount"`
+	CountLimit      int64  `json:"count_limit"`
+	AmountLimit     int64  `json:"amount_limit"`
+	CountRemaining  *int64 `json:"count_remaining"`
+	AmountRemaining *int64 `json:"amount_remaining"`
+}
+
+// GetLimitsResponse represents how much more money a wallet can receive
+// Amounts are in dirams (1 TJS = 100 dirams)
+type GetLimitsResponse struct {
+	AccountID    string           `json:"account_id"`
+	WalletType   string           `json:"wallet_type"`
+	Balance      int64            `json:"balance"`
+	MaxBalance   int64            `json:"max_balance"`
+	MaxOperation int64            `json:"max_operation"` // 0 when not enforced
+	MaxDeposit   int64            `json:"max_deposit"`   // largest deposit accepted right now
+	Currency     string           `json:"currency"`
+	Daily        TurnoverHeadroom `json:"daily"`
+	Monthly      TurnoverHeadroom `json:"monthly"`
 }
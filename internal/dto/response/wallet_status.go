@@ -0,0 +1,27 @@
+package response
+
+import "time"
+
+// ChangeWalletStatusResponse represents the response for a wallet status change
+type ChangeWalletStatusResponse struct {
+	Success        bool   `json:"success"`
+	AccountID      string `json:"account_id"`
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+}
+
+// WalletStatusChangeItem represents one wallet status change
+type WalletStatusChangeItem struct {
+	FromStatus    string    `json:"from_status"`
+	ToStatus      string    `json:"to_status"`
+	Reason        string    `json:"reason"`
+	ActorClientID int64     `json:"actor_client_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// WalletStatusHistoryResponse represents a wallet's status audit trail, oldest first
+type WalletStatusHistoryResponse struct {
+	AccountID string                   `json:"account_id"`
+	Status    string                   `json:"status"`
+	Changes   []WalletStatusChangeItem `json:"changes"`
+}
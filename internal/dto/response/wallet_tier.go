@@ -0,0 +1,20 @@
+package response
+
+// WalletTierItem represents a wallet tier with its limits.
+// Amounts are in dirams; a turnover limit of 0 is not enforced.
+type WalletTierItem struct {
+	WalletType    string `json:"wallet_type"`
+	Name          string `json:"name"`
+	MaxBalance    int64  `json:"max_balance"`
+	MaxOperation  int64  `json:"max_operation"`
+	DailyCount    int64  `json:"daily_count"`
+	DailyAmount   int64  `json:"daily_amount"`
+	MonthlyCount  int64  `json:"monthly_count"`
+	MonthlyAmount int64  `json:"monthly_amount"`
+}
+
+// WalletTiersResponse represents the available wallet tiers, lowest max balance first
+type WalletTiersResponse struct {
+	Currency string           `json:"currency"`
+	Tiers    []WalletTierItem `json:"tiers"`
+}
@@ -0,0 +1,79 @@
+package response
+
+import "time"
+
+// WebhookSubscriptionItem represents one webhook subscription
+type WebhookSubscriptionItem struct {
+	ID         int64     `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"` // empty means every event type
+	IsActive   bool      `json:"is_active"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// WebhookSubscriptionResponse represents the response for creating or removing a subscription
+type WebhookSubscriptionResponse struct {
+	Success      bool                    `json:"success"`
+	Subscription WebhookSubscriptionItem `json:"subscription"`
+}
+
+// WebhookSubscriptionsResponse represents the client's active webhook subscriptions
+type WebhookSubscriptionsResponse struct {
+	Subscriptions []WebhookSubscriptionItem `json:"subscriptions"`
+}
+
+// WebhookDeliveryItem represents one event delivery to one subscription
+type WebhookDeliveryItem struct {
+	ID             int64      `json:"id"`
+	SubscriptionID int64      `json:"subscription_id"`
+	EventID        string     `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Status         string     `json:"status"` // pending, delivered or dead
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	LastStatusCode int        `json:"last_status_code"` // 0 when no response was received
+	LastError      string     `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// WebhookDeliveriesResponse represents the client's latest webhook deliveries, newest first
+type WebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryItem `json:"deliveries"`
+}
+
+// RedeliverWebhookResponse represents the response for a manual redelivery
+type RedeliverWebhookResponse struct {
+	Success  bool                `json:"success"`
+	Delivery WebhookDeliveryItem `json:"delivery"`
+}
+
+// Webhook event payloads, sent as the "data" field of an event. Amounts are in dirams.
+
+// DepositCompletedEvent is the data of a deposit.completed event
+type DepositCompletedEvent struct {
+	AccountID     string `json:"account_id"`
+	TransactionID int64  `json:"transaction_id"`
+	ExternalID    string `json:"external_id,omitempty"`
+	Amount        int64  `json:"amount"`
+	NewBalance    int64  `json:"new_balance"`
+	Currency      string `json:"currency"`
+}
+
+// WalletLimitReachedEvent is the data of a wallet.limit_reached event:
+// the wallet accepts no further deposits until its balance or turnover allows it again
+type WalletLimitReachedEvent struct {
+	AccountID  string `json:"account_id"`
+	WalletType string `json:"wallet_type"`
+	Balance    int64  `json:"balance"`
+	MaxBalance int64  `json:"max_balance"`
+	Currency   string `json:"currency"`
+}
+
+// WalletStatusChangedEvent is the data of a wallet.status_changed event
+type WalletStatusChangedEvent struct {
+	AccountID      string `json:"account_id"`
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+	Reason         string `json:"reason"`
+}
@@ -0,0 +1,16 @@
+package response
+
+// WithdrawResponse represents the response for a withdrawal operation
+// Amounts are in dirams (1 TJS = 100 dirams). The wallet pays only the Amount; the Fee is the partner's
+// commission, drawn from its float, and NetAmount is the Amount net of it.
+type WithdrawResponse struct {
+	Success          bool   `json:"success"`
+	AccountID        string `json:"account_id"`
+	Amount           int64  `json:"amount"`
+	Fee              int64  `json:"fee"`
+	NetAmount        int64  `json:"net_amount"`
+	NewBalance       int64  `json:"new_balance"`
+	Currency         string `json:"currency"`
+	TransactionID    int64  `json:"transaction_id"`
+	FeeTransactionID *int64 `json:"fee_transaction_id,omitempty"`
+}
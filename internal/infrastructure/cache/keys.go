@@ -6,13 +6,24 @@ import (
 )
 
 const (
-	PrefixAPIClient = "api_client"
+	PrefixAPIClient   = "api_client"
+	PrefixIdempotency = "idempotency"
+	PrefixNonce       = "nonce"
 )
 
 const (
-	TTLAPIClient = 15 * time.Minute // API clients are cached for 15 minutes
+	TTLAPIClient   = 15 * time.Minute // API clients are cached for 15 minutes
+	TTLIdempotency = 24 * time.Hour   // Idempotent responses are cached for 24 hours
 )
 
 func BuildAPIClientKey(userID string) string {
 	return fmt.Sprintf("%s:%s", PrefixAPIClient, userID)
 }
+
+func BuildIdempotencyKey(clientID int64, key string) string {
+	return fmt.Sprintf("%s:%d:%s", PrefixIdempotency, clientID, key)
+}
+
+func BuildNonceKey(clientID int64, nonce string) string {
+	return fmt.Sprintf("%s:%d:%s", PrefixNonce, clientID, nonce)
+}
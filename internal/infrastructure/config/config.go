@@ -23,6 +23,12 @@ type Config struct {
 	Log         LogConfig         `yaml:"log"`
 	Auth        AuthConfig        `yaml:"auth"`
 	RateLimiter RateLimiterConfig `yaml:"rate_limiter"`
+	Holds       HoldsConfig       `yaml:"holds"`
+	Webhooks    WebhooksConfig    `yaml:"webhooks"`
+	Settlements SettlementsConfig `yaml:"settlements"`
+
+	// WalletTiers defines wallet types with their limits, keyed by type (e.g. identified, corporate)
+	WalletTiers map[string]WalletTierConfig `yaml:"wallet_tiers"`
 }
 
 // AppConfig - App params
@@ -31,6 +37,9 @@ type AppConfig struct {
 	Environment string `yaml:"environment"`
 	Version     string `yaml:"version"`
 	GinMode     string `yaml:"gin_mode"`
+	Timezone    string `yaml:"timezone"` // business time zone for day and month boundaries, e.g. Asia/Dushanbe
+
+	Location *time.Location `yaml:"-"` // resolved from Timezone when the config is loaded
 }
 
 // ServerConfig - http server params
@@ -81,7 +90,8 @@ type LogConfig struct {
 
 // AuthConfig - auth params
 type AuthConfig struct {
-	HMACAlgorithm string `yaml:"hmac_algorithm"`
+	HMACAlgorithm string        `yaml:"hmac_algorithm"`
+	TimestampSkew time.Duration `yaml:"timestamp_skew"` // accepted X-Timestamp drift for replay-protected clients
 }
 
 // RateLimiterConfig - rate limiter params
@@ -89,3 +99,38 @@ type RateLimiterConfig struct {
 	RequestsPerWindow int           `yaml:"requests_per_window"`
 	WindowDuration    time.Duration `yaml:"window_duration"`
 }
+
+// HoldsConfig - two-phase payment params
+type HoldsConfig struct {
+	TTL           time.Duration `yaml:"ttl"`            // how long authorized money stays reserved
+	SweepInterval time.Duration `yaml:"sweep_interval"` // how often expired holds are released
+}
+
+// WebhooksConfig - outgoing webhook delivery params
+type WebhooksConfig struct {
+	PollInterval time.Duration `yaml:"poll_interval"` // how often the outbox is checked for due deliveries
+	Timeout      time.Duration `yaml:"timeout"`       // per delivery attempt
+	BatchSize    int           `yaml:"batch_size"`    // deliveries sent concurrently per poll
+	MaxAttempts  int           `yaml:"max_attempts"`  // attempts before a delivery moves to the dead letter state
+	BackoffBase  time.Duration `yaml:"backoff_base"`  // delay after the first failure, doubled after each further one
+	BackoffMax   time.Duration `yaml:"backoff_max"`
+}
+
+// SettlementsConfig - daily partner settlement params
+type SettlementsConfig struct {
+	RunInterval time.Duration `yaml:"run_interval"`  // how often ended business days are settled
+	Delay       time.Duration `yaml:"delay"`         // grace period after midnight for operations still committing
+	CatchUpDays int           `yaml:"catch_up_days"` // ended business days checked on every run, so downtime leaves no gaps
+}
+
+// WalletTierConfig - balance limit and limits on money entering a wallet (deposits and incoming transfers).
+// A turnover limit of 0 is not enforced.
+type WalletTierConfig struct {
+	Name          string `yaml:"name"`           // display name
+	MaxBalance    int64  `yaml:"max_balance"`    // dirams
+	MaxOperation  int64  `yaml:"max_operation"`  // single operation, dirams
+	DailyCount    int64  `yaml:"daily_count"`    // operations per business day
+	DailyAmount   int64  `yaml:"daily_amount"`   // dirams per business day
+	MonthlyCount  int64  `yaml:"monthly_count"`  // operations per calendar month
+	MonthlyAmount int64  `yaml:"monthly_amount"` // dirams per calendar month
+}
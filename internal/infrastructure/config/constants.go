@@ -1,7 +1,48 @@
 package config
 
+import (
+	"regexp"
+	"time"
+)
+
 // HMAC algorithm constants
 const (
 	HMACAlgorithmSHA1   = "sha1"
 	HMACAlgorithmSHA256 = "sha256"
 )
+
+// Business time zone default
+const (
+	DefaultTimezone = "Asia/Dushanbe"
+)
+
+// walletTierPattern restricts wallet tier keys to values that fit wallets.type
+var walletTierPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,19}$`)
+
+// Replay protection defaults
+const (
+	DefaultTimestampSkew = 5 * time.Minute
+)
+
+// Hold defaults
+const (
+	DefaultHoldTTL           = 7 * 24 * time.Hour
+	DefaultHoldSweepInterval = time.Minute
+)
+
+// Webhook delivery defaults
+const (
+	DefaultWebhookPollInterval = 5 * time.Second
+	DefaultWebhookTimeout      = 10 * time.Second
+	DefaultWebhookBatchSize    = 20
+	DefaultWebhookMaxAttempts  = 8
+	DefaultWebhookBackoffBase  = 30 * time.Second
+	DefaultWebhookBackoffMax   = time.Hour
+)
+
+// Settlement defaults
+const (
+	DefaultSettlementRunInterval = time.Hour
+	DefaultSettlementDelay       = 10 * time.Minute
+	DefaultSettlementCatchUpDays = 7
+)
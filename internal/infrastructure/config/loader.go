@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"gopkg.in/yaml.v3"
@@ -28,6 +29,7 @@ func LoadConfig(configPath string) (*Config, error) {
 	}
 
 	overrideFromEnv(&AppParams)
+	applyDefaults(&AppParams)
 
 	if err := validate(&AppParams); err != nil {
 		return nil, fmt.Errorf("[config.LoadConfig]: config validation failed: %w", err)
@@ -66,6 +68,48 @@ func overrideFromEnv(AppParams *Config) {
 	}
 }
 
+func applyDefaults(AppParams *Config) {
+	if AppParams.App.Timezone == "" {
+		AppParams.App.Timezone = DefaultTimezone
+	}
+	if AppParams.Auth.TimestampSkew == 0 {
+		AppParams.Auth.TimestampSkew = DefaultTimestampSkew
+	}
+	if AppParams.Holds.TTL == 0 {
+		AppParams.Holds.TTL = DefaultHoldTTL
+	}
+	if AppParams.Holds.SweepInterval == 0 {
+		AppParams.Holds.SweepInterval = DefaultHoldSweepInterval
+	}
+	if AppParams.Webhooks.PollInterval == 0 {
+		AppParams.Webhooks.PollInterval = DefaultWebhookPollInterval
+	}
+	if AppParams.Webhooks.Timeout == 0 {
+		AppParams.Webhooks.Timeout = DefaultWebhookTimeout
+	}
+	if AppParams.Webhooks.BatchSize == 0 {
+		AppParams.Webhooks.BatchSize = DefaultWebhookBatchSize
+	}
+	if AppParams.Webhooks.MaxAttempts == 0 {
+		AppParams.Webhooks.MaxAttempts = DefaultWebhookMaxAttempts
+	}
+	if AppParams.Webhooks.BackoffBase == 0 {
+		AppParams.Webhooks.BackoffBase = DefaultWebhookBackoffBase
+	}
+	if AppParams.Webhooks.BackoffMax == 0 {
+		AppParams.Webhooks.BackoffMax = DefaultWebhookBackoffMax
+	}
+	if AppParams.Settlements.RunInterval == 0 {
+		AppParams.Settlements.RunInterval = DefaultSettlementRunInterval
+	}
+	if AppParams.Settlements.Delay == 0 {
+		AppParams.Settlements.Delay = DefaultSettlementDelay
+	}
+	if AppParams.Settlements.CatchUpDays == 0 {
+		AppParams.Settlements.CatchUpDays = DefaultSettlementCatchUpDays
+	}
+}
+
 func validate(AppParams *Config) error {
 	if AppParams.App.Name == "" {
 		return fmt.Errorf("[config.validate]: app.name is required")
@@ -73,6 +117,11 @@ func validate(AppParams *Config) error {
 	if AppParams.App.Environment != EnvironmentDevelopment && AppParams.App.Environment != EnvironmentProduction {
 		return fmt.Errorf("[config.validate]: app.environment must be '%s' or '%s'", EnvironmentDevelopment, EnvironmentProduction)
 	}
+	location, err := time.LoadLocation(AppParams.App.Timezone)
+	if err != nil {
+		return fmt.Errorf("[config.validate]: app.timezone '%s' is not a valid IANA time zone: %w", AppParams.App.Timezone, err)
+	}
+	AppParams.App.Location = location
 
 	if AppParams.Server.Port == "" {
 		return fmt.Errorf("[config.validate]: server.port is required")
@@ -101,6 +150,48 @@ func validate(AppParams *Config) error {
 	if AppParams.Auth.HMACAlgorithm != HMACAlgorithmSHA1 && AppParams.Auth.HMACAlgorithm != HMACAlgorithmSHA256 {
 		return fmt.Errorf("[config.validate]: auth.hmac_algorithm must be '%s' or '%s'", HMACAlgorithmSHA1, HMACAlgorithmSHA256)
 	}
+	if AppParams.Auth.TimestampSkew < 0 {
+		return fmt.Errorf("[config.validate]: auth.timestamp_skew must be positive")
+	}
+
+	if AppParams.Holds.TTL < 0 {
+		return fmt.Errorf("[config.validate]: holds.ttl must be positive")
+	}
+	if AppParams.Holds.SweepInterval < 0 {
+		return fmt.Errorf("[config.validate]: holds.sweep_interval must be positive")
+	}
+
+	webhooks := AppParams.Webhooks
+	if webhooks.PollInterval < 0 || webhooks.Timeout < 0 || webhooks.BackoffBase < 0 || webhooks.BackoffMax < 0 {
+		return fmt.Errorf("[config.validate]: webhooks durations must be positive")
+	}
+	if webhooks.BatchSize < 0 || webhooks.MaxAttempts < 0 {
+		return fmt.Errorf("[config.validate]: webhooks.batch_size and webhooks.max_attempts must be positive")
+	}
+	if webhooks.BackoffMax < webhooks.BackoffBase {
+		return fmt.Errorf("[config.validate]: webhooks.backoff_max must not be less than webhooks.backoff_base")
+	}
+
+	settlements := AppParams.Settlements
+	if settlements.RunInterval < 0 || settlements.Delay < 0 {
+		return fmt.Errorf("[config.validate]: settlements durations must be positive")
+	}
+	if settlements.CatchUpDays < 0 {
+		return fmt.Errorf("[config.validate]: settlements.catch_up_days must be positive")
+	}
+
+	for walletType, tier := range AppParams.WalletTiers {
+		if !walletTierPattern.MatchString(walletType) {
+			return fmt.Errorf("[config.validate]: wallet_tiers.%s: type must be lowercase letters, digits or '_' (max 20)", walletType)
+		}
+		if tier.MaxBalance <= 0 {
+			return fmt.Errorf("[config.validate]: wallet_tiers.%s.max_balance must be positive", walletType)
+		}
+		if tier.MaxOperation < 0 || tier.DailyCount < 0 || tier.DailyAmount < 0 ||
+			tier.MonthlyCount < 0 || tier.MonthlyAmount < 0 {
+			return fmt.Errorf("[config.validate]: wallet_tiers.%s limits must not be negative", walletType)
+		}
+	}
 
 	return nil
 }
@@ -3,8 +3,10 @@ package container
 import (
 	"e-wallet/internal/delivery/http"
 	"e-wallet/internal/delivery/http/handler"
+	"e-wallet/internal/domain/entity"
 	"e-wallet/internal/domain/repository"
 	"e-wallet/internal/domain/service"
+	"e-wallet/internal/domain/valueobject"
 	"e-wallet/internal/infrastructure/cache"
 	"e-wallet/internal/infrastructure/config"
 	"e-wallet/internal/infrastructure/database"
@@ -25,23 +27,81 @@ type Container struct {
 	Cache  *cache.RedisClient
 
 	// Repositories
-	WalletRepo      repository.WalletRepository
-	TransactionRepo repository.TransactionRepository
-	ClientRepo      repository.ClientRepository
-	CacheRepo       repository.CacheRepository
+	WalletRepo         repository.WalletRepository
+	TransactionRepo    repository.TransactionRepository
+	ClientRepo         repository.ClientRepository
+	IdempotencyRepo    repository.IdempotencyRepository
+	NonceRepo          repository.NonceRepository
+	IdentificationRepo repository.IdentificationRepository
+	StatusChangeRepo   repository.WalletStatusChangeRepository
+	LedgerRepo         repository.LedgerRepository
+	ReconciliationRepo repository.ReconciliationRepository
+	HoldRepo           repository.HoldRepository
+	WebhookSubRepo     repository.WebhookSubscriptionRepository
+	WebhookOutboxRepo  repository.WebhookOutboxRepository
+	FeeScheduleRepo    repository.FeeScheduleRepository
+	SettlementRepo     repository.SettlementRepository
+	CacheRepo          repository.CacheRepository
 
 	// Services
 	BalanceValidator *service.BalanceValidator
 
 	// Use Cases
-	WalletCheckUseCase        *usecase.WalletCheckUseCase
-	WalletDepositUseCase      *usecase.WalletDepositUseCase
-	WalletBalanceUseCase      *usecase.WalletBalanceUseCase
-	WalletMonthlyStatsUseCase *usecase.WalletMonthlyStatsUseCase
-	ClientCacheUseCase        *usecase.ClientCacheUseCase
+	WalletCheckUseCase           *usecase.WalletCheckUseCase
+	WalletCreateUseCase          *usecase.WalletCreateUseCase
+	WalletDepositUseCase         *usecase.WalletDepositUseCase
+	WalletWithdrawUseCase        *usecase.WalletWithdrawUseCase
+	WalletTransferUseCase        *usecase.WalletTransferUseCase
+	WalletBalanceUseCase         *usecase.WalletBalanceUseCase
+	WalletMonthlyStatsUseCase    *usecase.WalletMonthlyStatsUseCase
+	WalletTransactionsUseCase    *usecase.WalletTransactionsUseCase
+	WalletTiersUseCase           *usecase.WalletTiersUseCase
+	WalletLimitsUseCase          *usecase.WalletLimitsUseCase
+	WalletIdentifyUseCase        *usecase.WalletIdentifyUseCase
+	IdentificationReviewUseCase  *usecase.IdentificationReviewUseCase
+	IdentificationHistoryUseCase *usecase.IdentificationHistoryUseCase
+	WalletStatusUseCase          *usecase.WalletStatusUseCase
+	WalletStatusHistoryUseCase   *usecase.WalletStatusHistoryUseCase
+	ClientCacheUseCase           *usecase.ClientCacheUseCase
+	IdempotencyUseCase           *usecase.IdempotencyUseCase
+	ReplayGuardUseCase           *usecase.ReplayGuardUseCase
+	LedgerUseCase                *usecase.LedgerUseCase
+	LedgerTrialBalanceUseCase    *usecase.LedgerTrialBalanceUseCase
+	ReconciliationUseCase        *usecase.ReconciliationUseCase
+	TransactionReverseUseCase    *usecase.TransactionReverseUseCase
+	TransactionGetUseCase        *usecase.TransactionGetUseCase
+	TransactionStatusUseCase     *usecase.TransactionStatusUseCase
+	HoldAuthorizeUseCase         *usecase.HoldAuthorizeUseCase
+	HoldCaptureUseCase           *usecase.HoldCaptureUseCase
+	HoldVoidUseCase              *usecase.HoldVoidUseCase
+	WebhookPublisher             *usecase.WebhookPublisher
+	WebhookSubscribeUseCase      *usecase.WebhookSubscribeUseCase
+	WebhookUnsubscribeUseCase    *usecase.WebhookUnsubscribeUseCase
+	WebhookSubscriptionsUseCase  *usecase.WebhookSubscriptionsUseCase
+	WebhookDeliveriesUseCase     *usecase.WebhookDeliveriesUseCase
+	WebhookRedeliverUseCase      *usecase.WebhookRedeliverUseCase
+	FloatStatementUseCase        *usecase.FloatStatementUseCase
+	FloatTopUpUseCase            *usecase.FloatTopUpUseCase
+	FeeUseCase                   *usecase.FeeUseCase
+	FeeScheduleCreateUseCase     *usecase.FeeScheduleCreateUseCase
+	FeeSchedulesUseCase          *usecase.FeeSchedulesUseCase
+	SettlementsUseCase           *usecase.SettlementsUseCase
+	SettlementExportUseCase      *usecase.SettlementExportUseCase
+	SettlementRunUseCase         *usecase.SettlementRunUseCase
+
+	// Background workers
+	HoldSweeper       *usecase.HoldSweeper
+	WebhookDispatcher *usecase.WebhookDispatcher
+	SettlementJob     *usecase.SettlementJob
 
 	// Handlers
-	WalletHandler *handler.WalletHandler
+	WalletHandler      *handler.WalletHandler
+	TransactionHandler *handler.TransactionHandler
+	HoldHandler        *handler.HoldHandler
+	WebhookHandler     *handler.WebhookHandler
+	FloatHandler       *handler.FloatHandler
+	SettlementHandler  *handler.SettlementHandler
+	AdminHandler       *handler.AdminHandler
 
 	// Router
 	Router *gin.Engine
@@ -52,6 +112,11 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		Config: cfg,
 	}
 
+	// Register wallet tiers before anything reads wallets
+	if err := registerWalletTiers(cfg.WalletTiers); err != nil {
+		return nil, err
+	}
+
 	// Initialize database
 	db, err := database.NewPostgresDB(cfg.Database)
 	if err != nil {
@@ -83,6 +148,17 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 	c.WalletRepo = postgres.NewWalletRepository(db)
 	c.TransactionRepo = postgres.NewTransactionRepository(db)
 	c.ClientRepo = postgres.NewClientRepository(db)
+	c.IdempotencyRepo = postgres.NewIdempotencyRepository(db)
+	c.NonceRepo = postgres.NewNonceRepository(db)
+	c.IdentificationRepo = postgres.NewIdentificationRepository(db)
+	c.StatusChangeRepo = postgres.NewWalletStatusChangeRepository(db)
+	c.LedgerRepo = postgres.NewLedgerRepository(db)
+	c.ReconciliationRepo = postgres.NewReconciliationRepository(db)
+	c.HoldRepo = postgres.NewHoldRepository(db)
+	c.WebhookSubRepo = postgres.NewWebhookSubscriptionRepository(db)
+	c.WebhookOutboxRepo = postgres.NewWebhookOutboxRepository(db)
+	c.FeeScheduleRepo = postgres.NewFeeScheduleRepository(db)
+	c.SettlementRepo = postgres.NewSettlementRepository(db)
 
 	// Initialize cache repository if Redis is available
 	if c.Cache != nil {
@@ -90,20 +166,116 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 	}
 
 	// Initialize domain services
-	c.BalanceValidator = service.NewBalanceValidator()
+	c.BalanceValidator = service.NewBalanceValidator(c.TransactionRepo, cfg.App.Location)
 
 	// Initialize use cases
+	c.IdempotencyUseCase = usecase.NewIdempotencyUseCase(c.IdempotencyRepo, c.CacheRepo)
+	c.ReplayGuardUseCase = usecase.NewReplayGuardUseCase(c.NonceRepo, c.CacheRepo, cfg.Auth.TimestampSkew)
+	c.LedgerUseCase = usecase.NewLedgerUseCase(c.LedgerRepo)
+	c.WebhookPublisher = usecase.NewWebhookPublisher(c.WebhookSubRepo, c.WebhookOutboxRepo)
+	c.FeeUseCase = usecase.NewFeeUseCase(c.FeeScheduleRepo, c.TransactionRepo, c.LedgerUseCase)
 	c.WalletCheckUseCase = usecase.NewWalletCheckUseCase(c.WalletRepo)
+	c.WalletCreateUseCase = usecase.NewWalletCreateUseCase(c.WalletRepo)
 	c.WalletDepositUseCase = usecase.NewWalletDepositUseCase(
 		db,
 		c.WalletRepo,
 		c.TransactionRepo,
 		c.BalanceValidator,
+		c.LedgerUseCase,
+		c.FeeUseCase,
+		c.WebhookPublisher,
+		c.IdempotencyUseCase,
+	)
+	c.WalletWithdrawUseCase = usecase.NewWalletWithdrawUseCase(
+		db,
+		c.WalletRepo,
+		c.TransactionRepo,
+		c.BalanceValidator,
+		c.LedgerUseCase,
+		c.FeeUseCase,
+		c.IdempotencyUseCase,
+	)
+	c.WalletTransferUseCase = usecase.NewWalletTransferUseCase(
+		db,
+		c.WalletRepo,
+		c.TransactionRepo,
+		c.BalanceValidator,
+		c.LedgerUseCase,
+		c.FeeUseCase,
+		c.IdempotencyUseCase,
+	)
+	c.TransactionReverseUseCase = usecase.NewTransactionReverseUseCase(
+		db,
+		c.WalletRepo,
+		c.TransactionRepo,
+		c.LedgerUseCase,
+		c.FeeUseCase,
+		c.IdempotencyUseCase,
+	)
+	c.TransactionGetUseCase = usecase.NewTransactionGetUseCase(c.WalletRepo, c.TransactionRepo)
+	c.TransactionStatusUseCase = usecase.NewTransactionStatusUseCase(c.WalletRepo, c.TransactionRepo)
+	c.HoldAuthorizeUseCase = usecase.NewHoldAuthorizeUseCase(db, c.WalletRepo, c.HoldRepo, c.IdempotencyUseCase, cfg.Holds.TTL)
+	c.HoldCaptureUseCase = usecase.NewHoldCaptureUseCase(
+		db,
+		c.WalletRepo,
+		c.TransactionRepo,
+		c.HoldRepo,
+		c.LedgerUseCase,
+		c.IdempotencyUseCase,
 	)
+	c.HoldVoidUseCase = usecase.NewHoldVoidUseCase(db, c.WalletRepo, c.HoldRepo)
+	c.HoldSweeper = usecase.NewHoldSweeper(db, c.WalletRepo, c.HoldRepo, cfg.Holds.SweepInterval)
 	c.WalletBalanceUseCase = usecase.NewWalletBalanceUseCase(c.WalletRepo)
 	c.WalletMonthlyStatsUseCase = usecase.NewWalletMonthlyStatsUseCase(
 		c.WalletRepo,
 		c.TransactionRepo,
+		cfg.App.Location,
+	)
+	c.WalletTransactionsUseCase = usecase.NewWalletTransactionsUseCase(
+		c.WalletRepo,
+		c.TransactionRepo,
+	)
+	c.WalletTiersUseCase = usecase.NewWalletTiersUseCase()
+	c.WalletLimitsUseCase = usecase.NewWalletLimitsUseCase(c.WalletRepo, c.BalanceValidator)
+	c.WalletIdentifyUseCase = usecase.NewWalletIdentifyUseCase(db, c.WalletRepo, c.IdentificationRepo)
+	c.IdentificationReviewUseCase = usecase.NewIdentificationReviewUseCase(db, c.ClientRepo, c.WalletRepo, c.IdentificationRepo)
+	c.IdentificationHistoryUseCase = usecase.NewIdentificationHistoryUseCase(c.ClientRepo, c.WalletRepo, c.IdentificationRepo)
+	c.WalletStatusUseCase = usecase.NewWalletStatusUseCase(db, c.ClientRepo, c.WalletRepo, c.StatusChangeRepo, c.WebhookPublisher)
+	c.WalletStatusHistoryUseCase = usecase.NewWalletStatusHistoryUseCase(c.ClientRepo, c.WalletRepo, c.StatusChangeRepo)
+	c.LedgerTrialBalanceUseCase = usecase.NewLedgerTrialBalanceUseCase(c.LedgerRepo)
+	c.ReconciliationUseCase = usecase.NewReconciliationUseCase(db, c.ReconciliationRepo)
+	c.FloatStatementUseCase = usecase.NewFloatStatementUseCase(c.LedgerRepo, c.LedgerUseCase)
+	c.FloatTopUpUseCase = usecase.NewFloatTopUpUseCase(db, c.ClientRepo, c.LedgerRepo, c.LedgerUseCase)
+	c.FeeScheduleCreateUseCase = usecase.NewFeeScheduleCreateUseCase(db, c.ClientRepo, c.FeeScheduleRepo)
+	c.FeeSchedulesUseCase = usecase.NewFeeSchedulesUseCase(c.ClientRepo, c.FeeScheduleRepo)
+	c.SettlementJob = usecase.NewSettlementJob(
+		c.SettlementRepo,
+		cfg.App.Location,
+		cfg.Settlements.RunInterval,
+		cfg.Settlements.Delay,
+		cfg.Settlements.CatchUpDays,
+	)
+	c.SettlementsUseCase = usecase.NewSettlementsUseCase(c.SettlementRepo)
+	c.SettlementExportUseCase = usecase.NewSettlementExportUseCase(c.SettlementRepo)
+	c.SettlementRunUseCase = usecase.NewSettlementRunUseCase(c.SettlementJob, c.SettlementRepo, c.ClientRepo, cfg.App.Location)
+	c.WebhookSubscribeUseCase = usecase.NewWebhookSubscribeUseCase(c.WebhookSubRepo)
+	c.WebhookUnsubscribeUseCase = usecase.NewWebhookUnsubscribeUseCase(c.WebhookSubRepo)
+	c.WebhookSubscriptionsUseCase = usecase.NewWebhookSubscriptionsUseCase(c.WebhookSubRepo)
+	c.WebhookDeliveriesUseCase = usecase.NewWebhookDeliveriesUseCase(c.WebhookOutboxRepo)
+	c.WebhookRedeliverUseCase = usecase.NewWebhookRedeliverUseCase(c.WebhookSubRepo, c.WebhookOutboxRepo)
+	c.WebhookDispatcher = usecase.NewWebhookDispatcher(
+		c.WebhookOutboxRepo,
+		c.WebhookSubRepo,
+		c.ClientRepo,
+		crypto.HMACAlgorithm(cfg.Auth.HMACAlgorithm),
+		entity.WebhookRetryPolicy{
+			MaxAttempts: cfg.Webhooks.MaxAttempts,
+			BaseDelay:   cfg.Webhooks.BackoffBase,
+			MaxDelay:    cfg.Webhooks.BackoffMax,
+		},
+		cfg.Webhooks.PollInterval,
+		cfg.Webhooks.Timeout,
+		cfg.Webhooks.BatchSize,
 	)
 
 	// Initialize client cache use case if cache is available
@@ -114,17 +286,58 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 	// Initialize handlers
 	c.WalletHandler = handler.NewWalletHandler(
 		c.WalletCheckUseCase,
+		c.WalletCreateUseCase,
 		c.WalletDepositUseCase,
+		c.WalletWithdrawUseCase,
+		c.WalletTransferUseCase,
+		c.WalletIdentifyUseCase,
 		c.WalletBalanceUseCase,
 		c.WalletMonthlyStatsUseCase,
+		c.WalletTransactionsUseCase,
+		c.WalletTiersUseCase,
+		c.WalletLimitsUseCase,
+	)
+	c.TransactionHandler = handler.NewTransactionHandler(
+		c.TransactionReverseUseCase,
+		c.TransactionGetUseCase,
+		c.TransactionStatusUseCase,
+	)
+	c.HoldHandler = handler.NewHoldHandler(c.HoldAuthorizeUseCase, c.HoldCaptureUseCase, c.HoldVoidUseCase)
+	c.WebhookHandler = handler.NewWebhookHandler(
+		c.WebhookSubscribeUseCase,
+		c.WebhookUnsubscribeUseCase,
+		c.WebhookSubscriptionsUseCase,
+		c.WebhookDeliveriesUseCase,
+		c.WebhookRedeliverUseCase,
+	)
+	c.FloatHandler = handler.NewFloatHandler(c.FloatStatementUseCase)
+	c.SettlementHandler = handler.NewSettlementHandler(c.SettlementsUseCase, c.SettlementExportUseCase)
+	c.AdminHandler = handler.NewAdminHandler(
+		c.IdentificationReviewUseCase,
+		c.IdentificationHistoryUseCase,
+		c.WalletStatusUseCase,
+		c.WalletStatusHistoryUseCase,
+		c.LedgerTrialBalanceUseCase,
+		c.ReconciliationUseCase,
+		c.FloatTopUpUseCase,
+		c.FeeScheduleCreateUseCase,
+		c.FeeSchedulesUseCase,
+		c.SettlementRunUseCase,
 	)
 
 	// Initialize router
 	c.Router = http.NewRouter(&http.RouterConfig{
 		WalletHandler:       c.WalletHandler,
+		TransactionHandler:  c.TransactionHandler,
+		HoldHandler:         c.HoldHandler,
+		WebhookHandler:      c.WebhookHandler,
+		FloatHandler:        c.FloatHandler,
+		SettlementHandler:   c.SettlementHandler,
+		AdminHandler:        c.AdminHandler,
 		ClientRepo:          c.ClientRepo,
 		CacheRepo:           c.CacheRepo,
 		ClientCacheUseCase:  c.ClientCacheUseCase,
+		ReplayGuardUseCase:  c.ReplayGuardUseCase,
 		HMACAlgorithm:       crypto.HMACAlgorithm(cfg.Auth.HMACAlgorithm),
 		GinMode:             cfg.App.GinMode,
 		Environment:         cfg.App.Environment,
@@ -135,6 +348,38 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 	return c, nil
 }
 
+// registerWalletTiers makes the configured wallet tiers known to the domain
+func registerWalletTiers(cfg map[string]config.WalletTierConfig) error {
+	tiers := make([]valueobject.WalletTier, 0, len(cfg))
+	for name, tierCfg := range cfg {
+		maxBalance, err := valueobject.NewMoney(tierCfg.MaxBalance)
+		if err != nil {
+			return fmt.Errorf("[container.registerWalletTiers]: wallet_tiers.%s: %w", name, err)
+		}
+
+		displayName := tierCfg.Name
+		if displayName == "" {
+			displayName = name
+		}
+
+		tiers = append(tiers, valueobject.WalletTier{
+			Type:       valueobject.WalletType(name),
+			Name:       displayName,
+			MaxBalance: maxBalance,
+			Limits: valueobject.TurnoverLimits{
+				MaxOperation:  tierCfg.MaxOperation,
+				DailyCount:    tierCfg.DailyCount,
+				DailyAmount:   tierCfg.DailyAmount,
+				MonthlyCount:  tierCfg.MonthlyCount,
+				MonthlyAmount: tierCfg.MonthlyAmount,
+			},
+		})
+	}
+
+	valueobject.RegisterWalletTiers(tiers)
+	return nil
+}
+
 func (c *Container) Close() error {
 	if c.Cache != nil {
 		if err := c.Cache.Close(); err != nil {
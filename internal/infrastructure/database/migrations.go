@@ -3,6 +3,7 @@ package database
 import (
 	"e-wallet/internal/infrastructure/database/models"
 	"e-wallet/internal/infrastructure/logger"
+	"fmt"
 
 	"gorm.io/gorm"
 )
@@ -15,11 +16,76 @@ func RunMigrations(db *gorm.DB) error {
 		&models.APIClient{},
 		&models.Wallet{},
 		&models.Transaction{},
+		&models.IdempotencyKey{},
+		&models.RequestNonce{},
+		&models.KYCSubmission{},
+		&models.IdentificationEvent{},
+		&models.WalletStatusChange{},
+		&models.LedgerAccount{},
+		&models.JournalEntry{},
+		&models.LedgerPosting{},
+		&models.ReconciliationRun{},
+		&models.ReconciliationDiscrepancy{},
+		&models.Hold{},
+		&models.WebhookSubscription{},
+		&models.WebhookDelivery{},
+		&models.FeeSchedule{},
+		&models.FeeRule{},
+		&models.Settlement{},
 	)
 	if err != nil {
 		return err
 	}
 
+	if err := migrateData(db); err != nil {
+		return err
+	}
+
 	logger.Info.Println("Database migrations completed successfully")
 	return nil
 }
+
+// dataMigrations bring rows written by older versions up to date. AutoMigrate only adds tables, columns
+// and indexes, so each statement must be safe to run on every start.
+var dataMigrations = []struct {
+	name string
+	sql  string
+}{
+	{
+		// The status column defaults to completed, also for originals reversed before it existed
+		name: "mark originals reversed before statuses were tracked",
+		sql: `UPDATE transactions SET status = 'reversed'
+			WHERE id IN (SELECT original_transaction_id FROM transactions WHERE original_transaction_id IS NOT NULL)
+			AND status <> 'reversed'`,
+	},
+	{
+		// Reversals used to be limited to one per transaction by a unique index
+		name: "drop the one-reversal-per-transaction index",
+		sql:  `DROP INDEX IF EXISTS idx_transactions_original_transaction_id`,
+	},
+	{
+		name: "total the reversals recorded before reversed_amount was tracked",
+		sql: `UPDATE transactions t SET reversed_amount = r.total
+			FROM (SELECT original_transaction_id, SUM(amount) AS total FROM transactions
+				WHERE original_transaction_id IS NOT NULL GROUP BY original_transaction_id) r
+			WHERE t.id = r.original_transaction_id AND t.reversed_amount = 0`,
+	},
+	{
+		// Account IDs used to be unique across all partners, which revealed other partners' customers
+		name: "drop the global account_id unique index",
+		sql:  `DROP INDEX IF EXISTS idx_wallets_account_id`,
+	},
+}
+
+func migrateData(db *gorm.DB) error {
+	for _, migration := range dataMigrations {
+		result := db.Exec(migration.sql)
+		if result.Error != nil {
+			return fmt.Errorf("[database.migrateData]: %s: %w", migration.name, result.Error)
+		}
+		if result.RowsAffected > 0 {
+			logger.Info.Printf("Data migration \"%s\" updated %d rows", migration.name, result.RowsAffected)
+		}
+	}
+	return nil
+}
@@ -4,12 +4,14 @@ import "time"
 
 // APIClient represents the database model for API clients
 type APIClient struct {
-	ID        int64     `gorm:"primaryKey;autoIncrement"`
-	UserID    string    `gorm:"type:varchar(100);uniqueIndex;not null"`
-	SecretKey string    `gorm:"type:varchar(255);not null"`
-	IsActive  bool      `gorm:"not null;default:true"`
-	CreatedAt time.Time `gorm:"autoCreateTime"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	ID               int64     `gorm:"primaryKey;autoIncrement"`
+	UserID           string    `gorm:"type:varchar(100);uniqueIndex;not null"`
+	SecretKey        string    `gorm:"type:varchar(255);not null"`
+	IsActive         bool      `gorm:"not null;default:true"`
+	IsAdmin          bool      `gorm:"not null;default:false"` // may call /api/v1/admin endpoints
+	ReplayProtection bool      `gorm:"not null;default:false"` // opt-in signing of method, path, X-Timestamp and X-Nonce
+	CreatedAt        time.Time `gorm:"autoCreateTime"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime"`
 }
 
 // TableName specifies the table name for GORM
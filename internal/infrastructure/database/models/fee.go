@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// FeeSchedule represents the database model for versioned fee schedules
+type FeeSchedule struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement"`
+	ClientID      *int64    `gorm:"index:idx_fee_schedules_effective,priority:1"` // NULL for the default schedule
+	EffectiveFrom time.Time `gorm:"not null;index:idx_fee_schedules_effective,priority:2"`
+	CreatedBy     int64     `gorm:"not null"` // admin client that created the version
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (FeeSchedule) TableName() string {
+	return "fee_schedules"
+}
+
+// FeeRule represents the database model for one rule of a fee schedule (amounts in dirams)
+type FeeRule struct {
+	ID         int64  `gorm:"primaryKey;autoIncrement"`
+	ScheduleID int64  `gorm:"index;not null"`
+	Operation  string `gorm:"type:varchar(20);not null"`            // deposit, withdrawal or transfer
+	WalletType string `gorm:"type:varchar(50);not null;default:''"` // empty matches every wallet type
+	MinAmount  int64  `gorm:"not null;default:0"`
+	MaxAmount  int64  `gorm:"not null;default:0"` // 0 means no upper bound
+	FixedFee   int64  `gorm:"not null;default:0"`
+	PercentBps int64  `gorm:"not null;default:0"`
+	MinFee     int64  `gorm:"not null;default:0"`
+	MaxFee     int64  `gorm:"not null;default:0"` // 0 means no cap
+}
+
+// TableName specifies the table name for GORM
+func (FeeRule) TableName() string {
+	return "fee_rules"
+}
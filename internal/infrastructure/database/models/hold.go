@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// Hold represents the database model for holds (two-phase payments)
+type Hold struct {
+	ID             int64     `gorm:"primaryKey;autoIncrement"`
+	WalletID       int64     `gorm:"index;not null"`
+	ClientID       int64     `gorm:"index;not null"`
+	Amount         int64     `gorm:"not null;check:chk_holds_amount,amount > 0"`                          // dirams
+	CapturedAmount int64     `gorm:"not null;default:0"`                                                  // dirams
+	Status         string    `gorm:"type:varchar(20);not null;index:idx_holds_status_expires,priority:1"` // active, captured, voided, expired
+	Reference      string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt      time.Time `gorm:"not null;index:idx_holds_status_expires,priority:2"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (Hold) TableName() string {
+	return "holds"
+}
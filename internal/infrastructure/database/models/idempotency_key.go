@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// IdempotencyKey represents the database model for stored idempotent responses
+type IdempotencyKey struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement"`
+	ClientID    int64     `gorm:"not null;uniqueIndex:idx_idempotency_client_key"`
+	Key         string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_idempotency_client_key"`
+	Operation   string    `gorm:"type:varchar(50);not null"`
+	RequestHash string    `gorm:"type:varchar(64);not null"` // SHA-256 of operation and request body
+	Response    string    `gorm:"type:text;not null"`        // JSON-encoded response body
+	CreatedAt   time.Time `gorm:"autoCreateTime;index"`
+}
+
+// TableName specifies the table name for GORM
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
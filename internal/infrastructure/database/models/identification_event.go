@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// IdentificationEvent represents the database model for the wallet identification audit trail
+type IdentificationEvent struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement"`
+	WalletID      int64     `gorm:"index;not null"`
+	SubmissionID  int64     `gorm:"index;not null"`
+	FromStatus    string    `gorm:"type:varchar(30);not null"`
+	ToStatus      string    `gorm:"type:varchar(30);not null"`
+	ActorClientID int64     `gorm:"not null"` // api_clients.id that made the change
+	Reason        string    `gorm:"type:varchar(500)"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (IdentificationEvent) TableName() string {
+	return "wallet_identification_events"
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// KYCSubmission represents the database model for wallet identification requests
+type KYCSubmission struct {
+	ID             int64     `gorm:"primaryKey;autoIncrement"`
+	WalletID       int64     `gorm:"index;not null"`
+	ClientID       int64     `gorm:"index;not null"` // api_clients.id of the submitting partner
+	FullName       string    `gorm:"type:varchar(200);not null"`
+	DocumentType   string    `gorm:"type:varchar(30);not null"` // passport, national_id, residence_permit
+	DocumentNumber string    `gorm:"type:varchar(50);not null"`
+	DateOfBirth    time.Time `gorm:"type:date;not null"`
+	Status         string    `gorm:"type:varchar(20);not null;index"` // pending, approved, rejected
+	ReviewReason   string    `gorm:"type:varchar(500)"`
+	ReviewedAt     *time.Time
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (KYCSubmission) TableName() string {
+	return "kyc_submissions"
+}
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// LedgerAccount represents the database model for double-entry ledger accounts
+type LedgerAccount struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement"`
+	Code      string    `gorm:"type:varchar(64);uniqueIndex;not null"` // wallet:<id>, partner_float:<id>, system:<name>
+	Type      string    `gorm:"type:varchar(20);index;not null"`
+	WalletID  *int64    `gorm:"uniqueIndex"`
+	ClientID  *int64    `gorm:"index"`
+	Balance   int64     `gorm:"not null;default:0"` // credits minus debits (dirams)
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (LedgerAccount) TableName() string {
+	return "ledger_accounts"
+}
+
+// JournalEntry represents the database model for ledger journal entries
+type JournalEntry struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement"`
+	Kind      string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_journal_entries_float_top_up,priority:1,where:kind = 'float_top_up'"`
+	Reference string    `gorm:"type:varchar(64);index;uniqueIndex:idx_journal_entries_float_top_up,priority:2"` // matches transactions.reference; a bank reference tops up a float once
+	CreatedAt time.Time `gorm:"autoCreateTime;index"`
+}
+
+// TableName specifies the table name for GORM
+func (JournalEntry) TableName() string {
+	return "journal_entries"
+}
+
+// LedgerPosting represents the database model for a single debit or credit of a journal entry
+type LedgerPosting struct {
+	ID             int64     `gorm:"primaryKey;autoIncrement"`
+	JournalEntryID int64     `gorm:"index;not null"`
+	AccountID      int64     `gorm:"index;not null"`
+	Direction      string    `gorm:"type:varchar(6);not null"` // debit or credit
+	Amount         int64     `gorm:"not null;check:chk_ledger_postings_amount,amount > 0"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (LedgerPosting) TableName() string {
+	return "ledger_postings"
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// ReconciliationRun represents the database model for balance reconciliation runs
+type ReconciliationRun struct {
+	ID             int64     `gorm:"primaryKey;autoIncrement"`
+	ActorClientID  *int64    // api_clients.id of the admin; NULL when run from the command line
+	WalletsChecked int64     `gorm:"not null"`
+	Discrepancies  int64     `gorm:"not null"`
+	TotalStored    int64     `gorm:"not null"` // (dirams)
+	TotalComputed  int64     `gorm:"not null"` // (dirams)
+	StartedAt      time.Time `gorm:"not null"`
+	FinishedAt     time.Time `gorm:"not null;index"`
+}
+
+// TableName specifies the table name for GORM
+func (ReconciliationRun) TableName() string {
+	return "reconciliation_runs"
+}
+
+// ReconciliationDiscrepancy represents the database model for a wallet balance mismatch found by a run
+type ReconciliationDiscrepancy struct {
+	ID              int64     `gorm:"primaryKey;autoIncrement"`
+	RunID           int64     `gorm:"index;not null"`
+	WalletID        int64     `gorm:"index;not null"`
+	AccountID       string    `gorm:"type:varchar(50);not null"`
+	StoredBalance   int64     `gorm:"not null"` // wallets.balance (dirams)
+	ComputedBalance int64     `gorm:"not null"` // recomputed from transactions (dirams)
+	Difference      int64     `gorm:"not null"` // stored - computed (dirams)
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (ReconciliationDiscrepancy) TableName() string {
+	return "reconciliation_discrepancies"
+}
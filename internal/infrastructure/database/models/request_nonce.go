@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// RequestNonce represents the database model for nonces seen on replay-protected requests
+type RequestNonce struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement"`
+	ClientID  int64     `gorm:"not null;uniqueIndex:idx_request_nonces_client_nonce"`
+	Nonce     string    `gorm:"type:varchar(128);not null;uniqueIndex:idx_request_nonces_client_nonce"`
+	CreatedAt time.Time `gorm:"autoCreateTime;index"`
+}
+
+// TableName specifies the table name for GORM
+func (RequestNonce) TableName() string {
+	return "request_nonces"
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// Settlement represents the database model for daily partner settlements
+type Settlement struct {
+	ID                       int64     `gorm:"primaryKey;autoIncrement"`
+	ClientID                 int64     `gorm:"not null;uniqueIndex:idx_settlements_client_date,priority:1"`
+	BusinessDate             time.Time `gorm:"type:date;not null;index;uniqueIndex:idx_settlements_client_date,priority:2"`
+	DepositCount             int64     `gorm:"not null"`
+	DepositAmount            int64     `gorm:"not null"` // (dirams)
+	DepositReversalCount     int64     `gorm:"not null"`
+	DepositReversalAmount    int64     `gorm:"not null"` // (dirams)
+	WithdrawalCount          int64     `gorm:"not null"`
+	WithdrawalAmount         int64     `gorm:"not null"` // (dirams)
+	WithdrawalReversalCount  int64     `gorm:"not null"`
+	WithdrawalReversalAmount int64     `gorm:"not null"` // (dirams)
+	CaptureCount             int64     `gorm:"not null"`
+	CaptureAmount            int64     `gorm:"not null"` // (dirams)
+	FeeCount                 int64     `gorm:"not null"`
+	FeeAmount                int64     `gorm:"not null"` // (dirams)
+	FeeRefundCount           int64     `gorm:"not null;default:0"`
+	FeeRefundAmount          int64     `gorm:"not null;default:0"` // (dirams)
+	NetAmount                int64     `gorm:"not null"`           // owed by the partner (dirams), negative when owed to it
+	GeneratedAt              time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (Settlement) TableName() string {
+	return "settlements"
+}
@@ -4,11 +4,22 @@ import "time"
 
 // Transaction represents the database model for transactions
 type Transaction struct {
-	ID        int64     `gorm:"primaryKey;autoIncrement"`
-	WalletID  int64     `gorm:"index;not null"`
-	Type      string    `gorm:"type:varchar(20);not null"` // deposit, withdrawal, etc.
-	Amount    int64     `gorm:"not null"`                  // stored in minor units (dirams)
-	CreatedAt time.Time `gorm:"autoCreateTime;index"`
+	ID                    int64      `gorm:"primaryKey;autoIncrement;index:idx_transactions_wallet_history,priority:3"`
+	WalletID              int64      `gorm:"index;not null;index:idx_transactions_wallet_history,priority:1"`
+	ClientID              *int64     `gorm:"index;uniqueIndex:idx_transactions_client_external,priority:1"` // API client that made the operation; NULL for legacy rows
+	Type                  string     `gorm:"type:varchar(20);not null"`                                     // deposit, withdrawal, etc.
+	Amount                int64      `gorm:"not null"`                                                      // stored in minor units (dirams)
+	BalanceAfter          *int64     // wallet balance after the operation (dirams); NULL for legacy rows
+	Reference             string     `gorm:"type:varchar(64);index"`                                                   // links related rows (e.g. transfer legs)
+	OriginalTransactionID *int64     `gorm:"index:idx_transactions_original"`                                          // reversed transaction; it may be reversed in several parts
+	FeeRuleID             *int64     `gorm:"index"`                                                                    // fee rule that priced a fee transaction
+	ExternalID            *string    `gorm:"type:varchar(64);uniqueIndex:idx_transactions_client_external,priority:2"` // partner's own ID, unique per client
+	Description           string     `gorm:"type:varchar(255);not null;default:''"`
+	Metadata              *string    `gorm:"type:text"`                                     // JSON object of the partner's key/value pairs
+	Status                string     `gorm:"type:varchar(20);not null;default:'completed'"` // pending, completed, failed, reversed
+	ReversedAmount        int64      `gorm:"not null;default:0"`                            // total compensated by reversals (dirams)
+	CreatedAt             time.Time  `gorm:"autoCreateTime;index;index:idx_transactions_wallet_history,priority:2"`
+	UpdatedAt             *time.Time // last status change; NULL for rows recorded before statuses were tracked
 }
 
 // TableName specifies the table name for GORM
@@ -4,12 +4,16 @@ import "time"
 
 // Wallet represents the database model for wallets
 type Wallet struct {
-	ID        int64     `gorm:"primaryKey;autoIncrement"`
-	AccountID string    `gorm:"type:varchar(50);uniqueIndex;not null"`
-	Type      string    `gorm:"type:varchar(20);not null"` // identified or unidentified
-	Balance   int64     `gorm:"not null;default:0"`        // stored in minor units (dirams)
-	CreatedAt time.Time `gorm:"autoCreateTime"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	ID                   int64     `gorm:"primaryKey;autoIncrement"`
+	AccountID            string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_wallets_account_owner"`
+	Type                 string    `gorm:"type:varchar(20);not null"`                        // wallet tier, e.g. identified or unidentified
+	Status               string    `gorm:"type:varchar(20);not null;default:'active';index"` // active, frozen, blocked, closed
+	IdentificationStatus string    `gorm:"type:varchar(30);not null;default:'none'"`         // none, pending_identification, identified, rejected
+	Balance              int64     `gorm:"not null;default:0"`                               // stored in minor units (dirams)
+	HeldAmount           int64     `gorm:"not null;default:0"`                               // reserved by active holds (dirams)
+	OwnerClientID        *int64    `gorm:"index;uniqueIndex:idx_wallets_account_owner"`      // api_clients.id of the owning partner
+	CreatedAt            time.Time `gorm:"autoCreateTime"`
+	UpdatedAt            time.Time `gorm:"autoUpdateTime"`
 }
 
 // TableName specifies the table name for GORM
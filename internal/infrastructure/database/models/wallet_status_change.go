@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// WalletStatusChange represents the database model for the wallet status audit trail
+type WalletStatusChange struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement"`
+	WalletID      int64     `gorm:"index;not null"`
+	FromStatus    string    `gorm:"type:varchar(20);not null"`
+	ToStatus      string    `gorm:"type:varchar(20);not null"`
+	Reason        string    `gorm:"type:varchar(500);not null"`
+	ActorClientID int64     `gorm:"not null"` // api_clients.id of the admin
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (WalletStatusChange) TableName() string {
+	return "wallet_status_changes"
+}
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// WebhookSubscription represents the database model for webhook subscriptions
+type WebhookSubscription struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement"`
+	ClientID   int64     `gorm:"index;not null"`
+	URL        string    `gorm:"type:varchar(500);not null"`
+	EventTypes string    `gorm:"type:varchar(255);not null;default:''"` // comma-separated; empty means every event type
+	IsActive   bool      `gorm:"not null;default:true"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// WebhookDelivery represents the database model for the webhook outbox:
+// one row per event and subscription, written in the same DB transaction as the event
+type WebhookDelivery struct {
+	ID             int64     `gorm:"primaryKey;autoIncrement"`
+	SubscriptionID int64     `gorm:"not null;uniqueIndex:idx_webhook_outbox_event,priority:1"`
+	ClientID       int64     `gorm:"index;not null"`
+	EventID        string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_webhook_outbox_event,priority:2"`
+	EventType      string    `gorm:"type:varchar(50);not null"`
+	Payload        string    `gorm:"type:text;not null"`
+	Status         string    `gorm:"type:varchar(20);not null;index:idx_webhook_outbox_due,priority:1"` // pending, delivered, dead
+	Attempts       int       `gorm:"not null;default:0"`
+	NextAttemptAt  time.Time `gorm:"not null;index:idx_webhook_outbox_due,priority:2"`
+	LastError      string    `gorm:"type:varchar(500)"`
+	LastStatusCode int       `gorm:"not null;default:0"`
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (WebhookDelivery) TableName() string {
+	return "webhook_outbox"
+}
@@ -13,22 +13,26 @@ func NewClientMapper() *ClientMapper {
 
 func (m *ClientMapper) ToDomain(dbClient *models.APIClient) *entity.APIClient {
 	return &entity.APIClient{
-		ID:        dbClient.ID,
-		UserID:    dbClient.UserID,
-		SecretKey: dbClient.SecretKey,
-		IsActive:  dbClient.IsActive,
-		CreatedAt: dbClient.CreatedAt,
-		UpdatedAt: dbClient.UpdatedAt,
+		ID:               dbClient.ID,
+		UserID:           dbClient.UserID,
+		SecretKey:        dbClient.SecretKey,
+		IsActive:         dbClient.IsActive,
+		IsAdmin:          dbClient.IsAdmin,
+		ReplayProtection: dbClient.ReplayProtection,
+		CreatedAt:        dbClient.CreatedAt,
+		UpdatedAt:        dbClient.UpdatedAt,
 	}
 }
 
 func (m *ClientMapper) ToModel(client *entity.APIClient) *models.APIClient {
 	return &models.APIClient{
-		ID:        client.ID,
-		UserID:    client.UserID,
-		SecretKey: client.SecretKey,
-		IsActive:  client.IsActive,
-		CreatedAt: client.CreatedAt,
-		UpdatedAt: client.UpdatedAt,
+		ID:               client.ID,
+		UserID:           client.UserID,
+		SecretKey:        client.SecretKey,
+		IsActive:         client.IsActive,
+		IsAdmin:          client.IsAdmin,
+		ReplayProtection: client.ReplayProtection,
+		CreatedAt:        client.CreatedAt,
+		UpdatedAt:        client.UpdatedAt,
 	}
 }
@@ -0,0 +1,68 @@
+package mapper
+
+import (
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/infrastructure/database/models"
+)
+
+type FeeMapper struct{}
+
+func NewFeeMapper() *FeeMapper {
+	return &FeeMapper{}
+}
+
+func (m *FeeMapper) ScheduleToDomain(dbSchedule *models.FeeSchedule, dbRules []models.FeeRule) *entity.FeeSchedule {
+	rules := make([]*entity.FeeRule, 0, len(dbRules))
+	for i := range dbRules {
+		rules = append(rules, m.RuleToDomain(&dbRules[i]))
+	}
+
+	return &entity.FeeSchedule{
+		ID:            dbSchedule.ID,
+		ClientID:      dbSchedule.ClientID,
+		EffectiveFrom: dbSchedule.EffectiveFrom,
+		CreatedBy:     dbSchedule.CreatedBy,
+		Rules:         rules,
+		CreatedAt:     dbSchedule.CreatedAt,
+	}
+}
+
+func (m *FeeMapper) ScheduleToModel(schedule *entity.FeeSchedule) *models.FeeSchedule {
+	return &models.FeeSchedule{
+		ID:            schedule.ID,
+		ClientID:      schedule.ClientID,
+		EffectiveFrom: schedule.EffectiveFrom,
+		CreatedBy:     schedule.CreatedBy,
+		CreatedAt:     schedule.CreatedAt,
+	}
+}
+
+func (m *FeeMapper) RuleToDomain(dbRule *models.FeeRule) *entity.FeeRule {
+	return &entity.FeeRule{
+		ID:         dbRule.ID,
+		Operation:  entity.FeeOperation(dbRule.Operation),
+		WalletType: valueobject.WalletType(dbRule.WalletType),
+		MinAmount:  dbRule.MinAmount,
+		MaxAmount:  dbRule.MaxAmount,
+		FixedFee:   dbRule.FixedFee,
+		PercentBps: dbRule.PercentBps,
+		MinFee:     dbRule.MinFee,
+		MaxFee:     dbRule.MaxFee,
+	}
+}
+
+func (m *FeeMapper) RuleToModel(scheduleID int64, rule *entity.FeeRule) *models.FeeRule {
+	return &models.FeeRule{
+		ID:         rule.ID,
+		ScheduleID: scheduleID,
+		Operation:  string(rule.Operation),
+		WalletType: string(rule.WalletType),
+		MinAmount:  rule.MinAmount,
+		MaxAmount:  rule.MaxAmount,
+		FixedFee:   rule.FixedFee,
+		PercentBps: rule.PercentBps,
+		MinFee:     rule.MinFee,
+		MaxFee:     rule.MaxFee,
+	}
+}
@@ -0,0 +1,53 @@
+package mapper
+
+import (
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/infrastructure/database/models"
+)
+
+type HoldMapper struct{}
+
+func NewHoldMapper() *HoldMapper {
+	return &HoldMapper{}
+}
+
+func (m *HoldMapper) ToDomain(dbHold *models.Hold) (*entity.Hold, error) {
+	amount, err := valueobject.NewMoneyFromMinor(dbHold.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	capturedAmount, err := valueobject.NewMoneyFromMinor(dbHold.CapturedAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Hold{
+		ID:             dbHold.ID,
+		WalletID:       dbHold.WalletID,
+		ClientID:       dbHold.ClientID,
+		Amount:         amount,
+		CapturedAmount: capturedAmount,
+		Status:         entity.HoldStatus(dbHold.Status),
+		Reference:      dbHold.Reference,
+		ExpiresAt:      dbHold.ExpiresAt,
+		CreatedAt:      dbHold.CreatedAt,
+		UpdatedAt:      dbHold.UpdatedAt,
+	}, nil
+}
+
+func (m *HoldMapper) ToModel(hold *entity.Hold) *models.Hold {
+	return &models.Hold{
+		ID:             hold.ID,
+		WalletID:       hold.WalletID,
+		ClientID:       hold.ClientID,
+		Amount:         hold.Amount.Amount(),
+		CapturedAmount: hold.CapturedAmount.Amount(),
+		Status:         string(hold.Status),
+		Reference:      hold.Reference,
+		ExpiresAt:      hold.ExpiresAt,
+		CreatedAt:      hold.CreatedAt,
+		UpdatedAt:      hold.UpdatedAt,
+	}
+}
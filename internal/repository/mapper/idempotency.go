@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database/models"
+)
+
+type IdempotencyMapper struct{}
+
+func NewIdempotencyMapper() *IdempotencyMapper {
+	return &IdempotencyMapper{}
+}
+
+func (m *IdempotencyMapper) ToDomain(dbKey *models.IdempotencyKey) *entity.IdempotencyRecord {
+	return &entity.IdempotencyRecord{
+		ID:          dbKey.ID,
+		ClientID:    dbKey.ClientID,
+		Key:         dbKey.Key,
+		Operation:   dbKey.Operation,
+		RequestHash: dbKey.RequestHash,
+		Response:    dbKey.Response,
+		CreatedAt:   dbKey.CreatedAt,
+	}
+}
+
+func (m *IdempotencyMapper) ToModel(record *entity.IdempotencyRecord) *models.IdempotencyKey {
+	return &models.IdempotencyKey{
+		ID:          record.ID,
+		ClientID:    record.ClientID,
+		Key:         record.Key,
+		Operation:   record.Operation,
+		RequestHash: record.RequestHash,
+		Response:    record.Response,
+		CreatedAt:   record.CreatedAt,
+	}
+}
@@ -0,0 +1,73 @@
+package mapper
+
+import (
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/infrastructure/database/models"
+)
+
+type IdentificationMapper struct{}
+
+func NewIdentificationMapper() *IdentificationMapper {
+	return &IdentificationMapper{}
+}
+
+func (m *IdentificationMapper) SubmissionToDomain(dbSubmission *models.KYCSubmission) *entity.KYCSubmission {
+	return &entity.KYCSubmission{
+		ID:             dbSubmission.ID,
+		WalletID:       dbSubmission.WalletID,
+		ClientID:       dbSubmission.ClientID,
+		FullName:       dbSubmission.FullName,
+		DocumentType:   dbSubmission.DocumentType,
+		DocumentNumber: dbSubmission.DocumentNumber,
+		DateOfBirth:    dbSubmission.DateOfBirth,
+		Status:         entity.KYCSubmissionStatus(dbSubmission.Status),
+		ReviewReason:   dbSubmission.ReviewReason,
+		ReviewedAt:     dbSubmission.ReviewedAt,
+		CreatedAt:      dbSubmission.CreatedAt,
+		UpdatedAt:      dbSubmission.UpdatedAt,
+	}
+}
+
+func (m *IdentificationMapper) SubmissionToModel(submission *entity.KYCSubmission) *models.KYCSubmission {
+	return &models.KYCSubmission{
+		ID:             submission.ID,
+		WalletID:       submission.WalletID,
+		ClientID:       submission.ClientID,
+		FullName:       submission.FullName,
+		DocumentType:   submission.DocumentType,
+		DocumentNumber: submission.DocumentNumber,
+		DateOfBirth:    submission.DateOfBirth,
+		Status:         string(submission.Status),
+		ReviewReason:   submission.ReviewReason,
+		ReviewedAt:     submission.ReviewedAt,
+		CreatedAt:      submission.CreatedAt,
+		UpdatedAt:      submission.UpdatedAt,
+	}
+}
+
+func (m *IdentificationMapper) EventToDomain(dbEvent *models.IdentificationEvent) *entity.IdentificationEvent {
+	return &entity.IdentificationEvent{
+		ID:            dbEvent.ID,
+		WalletID:      dbEvent.WalletID,
+		SubmissionID:  dbEvent.SubmissionID,
+		FromStatus:    valueobject.IdentificationStatus(dbEvent.FromStatus),
+		ToStatus:      valueobject.IdentificationStatus(dbEvent.ToStatus),
+		ActorClientID: dbEvent.ActorClientID,
+		Reason:        dbEvent.Reason,
+		CreatedAt:     dbEvent.CreatedAt,
+	}
+}
+
+func (m *IdentificationMapper) EventToModel(event *entity.IdentificationEvent) *models.IdentificationEvent {
+	return &models.IdentificationEvent{
+		ID:            event.ID,
+		WalletID:      event.WalletID,
+		SubmissionID:  event.SubmissionID,
+		FromStatus:    event.FromStatus.String(),
+		ToStatus:      event.ToStatus.String(),
+		ActorClientID: event.ActorClientID,
+		Reason:        event.Reason,
+		CreatedAt:     event.CreatedAt,
+	}
+}
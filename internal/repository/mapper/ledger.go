@@ -0,0 +1,69 @@
+package mapper
+
+import (
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database/models"
+)
+
+type LedgerMapper struct{}
+
+func NewLedgerMapper() *LedgerMapper {
+	return &LedgerMapper{}
+}
+
+func (m *LedgerMapper) AccountToDomain(dbAccount *models.LedgerAccount) *entity.LedgerAccount {
+	account := &entity.LedgerAccount{
+		ID:        dbAccount.ID,
+		Code:      dbAccount.Code,
+		Type:      entity.LedgerAccountType(dbAccount.Type),
+		Balance:   dbAccount.Balance,
+		CreatedAt: dbAccount.CreatedAt,
+		UpdatedAt: dbAccount.UpdatedAt,
+	}
+	if dbAccount.WalletID != nil {
+		account.WalletID = *dbAccount.WalletID
+	}
+	if dbAccount.ClientID != nil {
+		account.ClientID = *dbAccount.ClientID
+	}
+	return account
+}
+
+func (m *LedgerMapper) AccountToModel(account *entity.LedgerAccount) *models.LedgerAccount {
+	dbAccount := &models.LedgerAccount{
+		ID:        account.ID,
+		Code:      account.Code,
+		Type:      string(account.Type),
+		Balance:   account.Balance,
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+	}
+	if account.WalletID != 0 {
+		walletID := account.WalletID
+		dbAccount.WalletID = &walletID
+	}
+	if account.ClientID != 0 {
+		clientID := account.ClientID
+		dbAccount.ClientID = &clientID
+	}
+	return dbAccount
+}
+
+func (m *LedgerMapper) EntryToModel(entry *entity.JournalEntry) *models.JournalEntry {
+	return &models.JournalEntry{
+		ID:        entry.ID,
+		Kind:      string(entry.Kind),
+		Reference: entry.Reference,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+func (m *LedgerMapper) PostingToModel(entryID int64, posting entity.Posting) *models.LedgerPosting {
+	return &models.LedgerPosting{
+		ID:             posting.ID,
+		JournalEntryID: entryID,
+		AccountID:      posting.AccountID,
+		Direction:      string(posting.Direction),
+		Amount:         posting.Amount.Amount(),
+	}
+}
@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database/models"
+)
+
+type ReconciliationMapper struct{}
+
+func NewReconciliationMapper() *ReconciliationMapper {
+	return &ReconciliationMapper{}
+}
+
+func (m *ReconciliationMapper) RunToModel(run *entity.ReconciliationRun) *models.ReconciliationRun {
+	var actorClientID *int64
+	if run.ActorClientID != 0 {
+		actor := run.ActorClientID
+		actorClientID = &actor
+	}
+
+	return &models.ReconciliationRun{
+		ID:             run.ID,
+		ActorClientID:  actorClientID,
+		WalletsChecked: run.WalletsChecked,
+		Discrepancies:  int64(len(run.Discrepancies)),
+		TotalStored:    run.TotalStored,
+		TotalComputed:  run.TotalComputed,
+		StartedAt:      run.StartedAt,
+		FinishedAt:     run.FinishedAt,
+	}
+}
+
+func (m *ReconciliationMapper) DiscrepancyToModel(runID int64, discrepancy *entity.ReconciliationDiscrepancy) *models.ReconciliationDiscrepancy {
+	return &models.ReconciliationDiscrepancy{
+		ID:              discrepancy.ID,
+		RunID:           runID,
+		WalletID:        discrepancy.WalletID,
+		AccountID:       discrepancy.AccountID,
+		StoredBalance:   discrepancy.StoredBalance,
+		ComputedBalance: discrepancy.ComputedBalance,
+		Difference:      discrepancy.Difference(),
+		CreatedAt:       discrepancy.CreatedAt,
+	}
+}
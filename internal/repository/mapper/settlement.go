@@ -0,0 +1,60 @@
+package mapper
+
+import (
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database/models"
+)
+
+type SettlementMapper struct{}
+
+func NewSettlementMapper() *SettlementMapper {
+	return &SettlementMapper{}
+}
+
+func (m *SettlementMapper) ToModel(settlement *entity.Settlement) *models.Settlement {
+	return &models.Settlement{
+		ID:                       settlement.ID,
+		ClientID:                 settlement.ClientID,
+		BusinessDate:             settlement.BusinessDate,
+		DepositCount:             settlement.DepositCount,
+		DepositAmount:            settlement.DepositAmount,
+		DepositReversalCount:     settlement.DepositReversalCount,
+		DepositReversalAmount:    settlement.DepositReversalAmount,
+		WithdrawalCount:          settlement.WithdrawalCount,
+		WithdrawalAmount:         settlement.WithdrawalAmount,
+		WithdrawalReversalCount:  settlement.WithdrawalReversalCount,
+		WithdrawalReversalAmount: settlement.WithdrawalReversalAmount,
+		CaptureCount:             settlement.CaptureCount,
+		CaptureAmount:            settlement.CaptureAmount,
+		FeeCount:                 settlement.FeeCount,
+		FeeAmount:                settlement.FeeAmount,
+		FeeRefundCount:           settlement.FeeRefundCount,
+		FeeRefundAmount:          settlement.FeeRefundAmount,
+		NetAmount:                settlement.NetAmount,
+		GeneratedAt:              settlement.GeneratedAt,
+	}
+}
+
+func (m *SettlementMapper) ToDomain(dbSettlement *models.Settlement) *entity.Settlement {
+	return &entity.Settlement{
+		ID:                       dbSettlement.ID,
+		ClientID:                 dbSettlement.ClientID,
+		BusinessDate:             dbSettlement.BusinessDate,
+		DepositCount:             dbSettlement.DepositCount,
+		DepositAmount:            dbSettlement.DepositAmount,
+		DepositReversalCount:     dbSettlement.DepositReversalCount,
+		DepositReversalAmount:    dbSettlement.DepositReversalAmount,
+		WithdrawalCount:          dbSettlement.WithdrawalCount,
+		WithdrawalAmount:         dbSettlement.WithdrawalAmount,
+		WithdrawalReversalCount:  dbSettlement.WithdrawalReversalCount,
+		WithdrawalReversalAmount: dbSettlement.WithdrawalReversalAmount,
+		CaptureCount:             dbSettlement.CaptureCount,
+		CaptureAmount:            dbSettlement.CaptureAmount,
+		FeeCount:                 dbSettlement.FeeCount,
+		FeeAmount:                dbSettlement.FeeAmount,
+		FeeRefundCount:           dbSettlement.FeeRefundCount,
+		FeeRefundAmount:          dbSettlement.FeeRefundAmount,
+		NetAmount:                dbSettlement.NetAmount,
+		GeneratedAt:              dbSettlement.GeneratedAt,
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"e-wallet/internal/domain/entity"
 	"e-wallet/internal/domain/valueobject"
 	"e-wallet/internal/infrastructure/database/models"
+	"encoding/json"
+	"fmt"
+	"time"
 )
 
 type TransactionMapper struct{}
@@ -18,21 +21,114 @@ func (m *TransactionMapper) ToDomain(dbTx *models.Transaction) (*entity.Transact
 		return nil, err
 	}
 
+	var balanceAfter *valueobject.Money
+	if dbTx.BalanceAfter != nil {
+		balance, err := valueobject.NewMoneyFromMinor(*dbTx.BalanceAfter)
+		if err != nil {
+			return nil, err
+		}
+		balanceAfter = &balance
+	}
+
+	var clientID int64
+	if dbTx.ClientID != nil {
+		clientID = *dbTx.ClientID
+	}
+
+	var externalID string
+	if dbTx.ExternalID != nil {
+		externalID = *dbTx.ExternalID
+	}
+
+	reversedAmount, err := valueobject.NewMoneyFromMinor(dbTx.ReversedAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt := dbTx.CreatedAt
+	if dbTx.UpdatedAt != nil {
+		updatedAt = *dbTx.UpdatedAt
+	}
+
+	status := entity.TransactionStatus(dbTx.Status)
+	if !status.IsValid() {
+		return nil, fmt.Errorf("transaction %d has unknown status %q", dbTx.ID, dbTx.Status)
+	}
+
+	var metadata map[string]string
+	if dbTx.Metadata != nil {
+		if err := json.Unmarshal([]byte(*dbTx.Metadata), &metadata); err != nil {
+			return nil, err
+		}
+	}
+
 	return &entity.Transaction{
-		ID:        dbTx.ID,
-		WalletID:  dbTx.WalletID,
-		Type:      entity.TransactionType(dbTx.Type),
-		Amount:    amount,
-		CreatedAt: dbTx.CreatedAt,
+		ID:                    dbTx.ID,
+		WalletID:              dbTx.WalletID,
+		ClientID:              clientID,
+		Type:                  entity.TransactionType(dbTx.Type),
+		Amount:                amount,
+		BalanceAfter:          balanceAfter,
+		Reference:             dbTx.Reference,
+		OriginalTransactionID: dbTx.OriginalTransactionID,
+		FeeRuleID:             dbTx.FeeRuleID,
+		ExternalID:            externalID,
+		Description:           dbTx.Description,
+		Metadata:              metadata,
+		Status:                status,
+		ReversedAmount:        reversedAmount,
+		CreatedAt:             dbTx.CreatedAt,
+		UpdatedAt:             updatedAt,
 	}, nil
 }
 
 func (m *TransactionMapper) ToModel(tx *entity.Transaction) *models.Transaction {
+	var balanceAfter *int64
+	if tx.BalanceAfter != nil {
+		balance := tx.BalanceAfter.Amount()
+		balanceAfter = &balance
+	}
+
+	var clientID *int64
+	if tx.ClientID != 0 {
+		clientID = &tx.ClientID
+	}
+
+	// NULL rather than empty, so transactions without an external ID do not collide in the unique index
+	var externalID *string
+	if tx.ExternalID != "" {
+		externalID = &tx.ExternalID
+	}
+
+	var updatedAt *time.Time
+	if !tx.UpdatedAt.IsZero() {
+		updatedAt = &tx.UpdatedAt
+	}
+
+	var metadata *string
+	if tx.Metadata != nil {
+		// Marshalling a map of strings cannot fail
+		encoded, _ := json.Marshal(tx.Metadata)
+		value := string(encoded)
+		metadata = &value
+	}
+
 	return &models.Transaction{
-		ID:        tx.ID,
-		WalletID:  tx.WalletID,
-		Type:      string(tx.Type),
-		Amount:    tx.Amount.Amount(),
-		CreatedAt: tx.CreatedAt,
+		ID:                    tx.ID,
+		WalletID:              tx.WalletID,
+		ClientID:              clientID,
+		Type:                  string(tx.Type),
+		Amount:                tx.Amount.Amount(),
+		BalanceAfter:          balanceAfter,
+		Reference:             tx.Reference,
+		OriginalTransactionID: tx.OriginalTransactionID,
+		FeeRuleID:             tx.FeeRuleID,
+		ExternalID:            externalID,
+		Description:           tx.Description,
+		Metadata:              metadata,
+		Status:                string(tx.Status),
+		ReversedAmount:        tx.ReversedAmount.Amount(),
+		CreatedAt:             tx.CreatedAt,
+		UpdatedAt:             updatedAt,
 	}
 }
@@ -28,23 +28,51 @@ func (m *WalletMapper) ToDomain(dbWallet *models.Wallet) (*entity.Wallet, error)
 		return nil, err
 	}
 
+	heldAmount, err := valueobject.NewMoneyFromMinor(dbWallet.HeldAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := valueobject.NewWalletStatus(dbWallet.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	var ownerClientID int64
+	if dbWallet.OwnerClientID != nil {
+		ownerClientID = *dbWallet.OwnerClientID
+	}
+
 	return &entity.Wallet{
-		ID:        dbWallet.ID,
-		AccountID: accountID,
-		Type:      walletType,
-		Balance:   balance,
-		CreatedAt: dbWallet.CreatedAt,
-		UpdatedAt: dbWallet.UpdatedAt,
+		ID:                   dbWallet.ID,
+		AccountID:            accountID,
+		Type:                 walletType,
+		Status:               status,
+		IdentificationStatus: valueobject.IdentificationStatus(dbWallet.IdentificationStatus),
+		Balance:              balance,
+		HeldAmount:           heldAmount,
+		OwnerClientID:        ownerClientID,
+		CreatedAt:            dbWallet.CreatedAt,
+		UpdatedAt:            dbWallet.UpdatedAt,
 	}, nil
 }
 
 func (m *WalletMapper) ToModel(wallet *entity.Wallet) *models.Wallet {
+	var ownerClientID *int64
+	if wallet.OwnerClientID != 0 {
+		ownerClientID = &wallet.OwnerClientID
+	}
+
 	return &models.Wallet{
-		ID:        wallet.ID,
-		AccountID: wallet.AccountID.Value(),
-		Type:      wallet.Type.String(),
-		Balance:   wallet.Balance.Amount(),
-		CreatedAt: wallet.CreatedAt,
-		UpdatedAt: wallet.UpdatedAt,
+		ID:                   wallet.ID,
+		AccountID:            wallet.AccountID.Value(),
+		Type:                 wallet.Type.String(),
+		Status:               wallet.Status.String(),
+		IdentificationStatus: wallet.IdentificationStatus.String(),
+		Balance:              wallet.Balance.Amount(),
+		HeldAmount:           wallet.HeldAmount.Amount(),
+		OwnerClientID:        ownerClientID,
+		CreatedAt:            wallet.CreatedAt,
+		UpdatedAt:            wallet.UpdatedAt,
 	}
 }
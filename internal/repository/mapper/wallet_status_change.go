@@ -0,0 +1,37 @@
+package mapper
+
+import (
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/infrastructure/database/models"
+)
+
+type WalletStatusChangeMapper struct{}
+
+func NewWalletStatusChangeMapper() *WalletStatusChangeMapper {
+	return &WalletStatusChangeMapper{}
+}
+
+func (m *WalletStatusChangeMapper) ToDomain(dbChange *models.WalletStatusChange) *entity.WalletStatusChange {
+	return &entity.WalletStatusChange{
+		ID:            dbChange.ID,
+		WalletID:      dbChange.WalletID,
+		FromStatus:    valueobject.WalletStatus(dbChange.FromStatus),
+		ToStatus:      valueobject.WalletStatus(dbChange.ToStatus),
+		Reason:        dbChange.Reason,
+		ActorClientID: dbChange.ActorClientID,
+		CreatedAt:     dbChange.CreatedAt,
+	}
+}
+
+func (m *WalletStatusChangeMapper) ToModel(change *entity.WalletStatusChange) *models.WalletStatusChange {
+	return &models.WalletStatusChange{
+		ID:            change.ID,
+		WalletID:      change.WalletID,
+		FromStatus:    change.FromStatus.String(),
+		ToStatus:      change.ToStatus.String(),
+		Reason:        change.Reason,
+		ActorClientID: change.ActorClientID,
+		CreatedAt:     change.CreatedAt,
+	}
+}
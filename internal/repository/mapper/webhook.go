@@ -0,0 +1,87 @@
+package mapper
+
+import (
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database/models"
+	"strings"
+)
+
+type WebhookMapper struct{}
+
+func NewWebhookMapper() *WebhookMapper {
+	return &WebhookMapper{}
+}
+
+func (m *WebhookMapper) SubscriptionToDomain(dbSubscription *models.WebhookSubscription) *entity.WebhookSubscription {
+	var eventTypes []entity.WebhookEventType
+	if dbSubscription.EventTypes != "" {
+		for _, eventType := range strings.Split(dbSubscription.EventTypes, ",") {
+			eventTypes = append(eventTypes, entity.WebhookEventType(eventType))
+		}
+	}
+
+	return &entity.WebhookSubscription{
+		ID:         dbSubscription.ID,
+		ClientID:   dbSubscription.ClientID,
+		URL:        dbSubscription.URL,
+		EventTypes: eventTypes,
+		IsActive:   dbSubscription.IsActive,
+		CreatedAt:  dbSubscription.CreatedAt,
+		UpdatedAt:  dbSubscription.UpdatedAt,
+	}
+}
+
+func (m *WebhookMapper) SubscriptionToModel(subscription *entity.WebhookSubscription) *models.WebhookSubscription {
+	eventTypes := make([]string, len(subscription.EventTypes))
+	for i, eventType := range subscription.EventTypes {
+		eventTypes[i] = string(eventType)
+	}
+
+	return &models.WebhookSubscription{
+		ID:         subscription.ID,
+		ClientID:   subscription.ClientID,
+		URL:        subscription.URL,
+		EventTypes: strings.Join(eventTypes, ","),
+		IsActive:   subscription.IsActive,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+}
+
+func (m *WebhookMapper) DeliveryToDomain(dbDelivery *models.WebhookDelivery) *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:             dbDelivery.ID,
+		SubscriptionID: dbDelivery.SubscriptionID,
+		ClientID:       dbDelivery.ClientID,
+		EventID:        dbDelivery.EventID,
+		EventType:      entity.WebhookEventType(dbDelivery.EventType),
+		Payload:        dbDelivery.Payload,
+		Status:         entity.WebhookDeliveryStatus(dbDelivery.Status),
+		Attempts:       dbDelivery.Attempts,
+		NextAttemptAt:  dbDelivery.NextAttemptAt,
+		LastError:      dbDelivery.LastError,
+		LastStatusCode: dbDelivery.LastStatusCode,
+		DeliveredAt:    dbDelivery.DeliveredAt,
+		CreatedAt:      dbDelivery.CreatedAt,
+		UpdatedAt:      dbDelivery.UpdatedAt,
+	}
+}
+
+func (m *WebhookMapper) DeliveryToModel(delivery *entity.WebhookDelivery) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		ClientID:       delivery.ClientID,
+		EventID:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastError:      delivery.LastError,
+		LastStatusCode: delivery.LastStatusCode,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
@@ -41,6 +41,22 @@ func (r *ClientRepository) FindByUserID(ctx context.Context, userID string) (*en
 	return r.mapper.ToDomain(&dbClient), nil
 }
 
+// FindByID retrieves an API client by ID
+func (r *ClientRepository) FindByID(ctx context.Context, id int64) (*entity.APIClient, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbClient models.APIClient
+	err := db.WithContext(ctx).First(&dbClient, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrClientNotFound
+		}
+		logger.Error.Printf("[postgres.FindByID]: Failed to find client by id %d: %v", id, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.ToDomain(&dbClient), nil
+}
+
 // Create creates a new API client
 func (r *ClientRepository) Create(ctx context.Context, client *entity.APIClient) error {
 	db := database.GetDB(ctx, r.db)
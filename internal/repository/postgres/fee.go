@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/mapper"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type FeeScheduleRepository struct {
+	db     *gorm.DB
+	mapper *mapper.FeeMapper
+}
+
+func NewFeeScheduleRepository(db *gorm.DB) *FeeScheduleRepository {
+	return &FeeScheduleRepository{
+		db:     db,
+		mapper: mapper.NewFeeMapper(),
+	}
+}
+
+// Create must run inside a database transaction so a schedule is never stored without its rules
+func (r *FeeScheduleRepository) Create(ctx context.Context, schedule *entity.FeeSchedule) error {
+	db := database.GetDB(ctx, r.db)
+
+	dbSchedule := r.mapper.ScheduleToModel(schedule)
+	if err := db.WithContext(ctx).Create(dbSchedule).Error; err != nil {
+		logger.Error.Printf("[postgres.Create]: Failed to create fee schedule: %v", err)
+		return apperrors.TranslateError(err)
+	}
+	schedule.ID = dbSchedule.ID
+	schedule.CreatedAt = dbSchedule.CreatedAt
+
+	if len(schedule.Rules) == 0 {
+		return nil
+	}
+
+	dbRules := make([]*models.FeeRule, 0, len(schedule.Rules))
+	for _, rule := range schedule.Rules {
+		dbRules = append(dbRules, r.mapper.RuleToModel(schedule.ID, rule))
+	}
+	if err := db.WithContext(ctx).Create(dbRules).Error; err != nil {
+		logger.Error.Printf("[postgres.Create]: Failed to create rules of fee schedule %d: %v", schedule.ID, err)
+		return apperrors.TranslateError(err)
+	}
+	for i := range schedule.Rules {
+		schedule.Rules[i].ID = dbRules[i].ID
+	}
+
+	return nil
+}
+
+func (r *FeeScheduleRepository) FindEffective(ctx context.Context, clientID int64, at time.Time) (*entity.FeeSchedule, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbSchedule models.FeeSchedule
+	// The client's own schedule sorts before the default one
+	err := db.WithContext(ctx).
+		Where("(client_id = ? OR client_id IS NULL) AND effective_from <= ?", clientID, at).
+		Order("client_id IS NULL, effective_from DESC, id DESC").
+		First(&dbSchedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrRecordNotFound
+		}
+		logger.Error.Printf("[postgres.FindEffective]: Failed to find fee schedule for client_id %d: %v", clientID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	schedules, err := r.withRules(ctx, []models.FeeSchedule{dbSchedule})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules[0], nil
+}
+
+func (r *FeeScheduleRepository) FindByClient(ctx context.Context, clientID *int64) ([]*entity.FeeSchedule, error) {
+	db := database.GetDB(ctx, r.db)
+	query := db.WithContext(ctx)
+	if clientID != nil {
+		query = query.Where("client_id = ?", *clientID)
+	} else {
+		query = query.Where("client_id IS NULL")
+	}
+
+	var dbSchedules []models.FeeSchedule
+	err := query.Order("effective_from DESC, id DESC").Find(&dbSchedules).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindByClient]: Failed to find fee schedules: %v", err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.withRules(ctx, dbSchedules)
+}
+
+// withRules loads the rules of the schedules in one query, in the order they were created
+func (r *FeeScheduleRepository) withRules(ctx context.Context, dbSchedules []models.FeeSchedule) ([]*entity.FeeSchedule, error) {
+	if len(dbSchedules) == 0 {
+		return []*entity.FeeSchedule{}, nil
+	}
+
+	scheduleIDs := make([]int64, 0, len(dbSchedules))
+	for _, dbSchedule := range dbSchedules {
+		scheduleIDs = append(scheduleIDs, dbSchedule.ID)
+	}
+
+	db := database.GetDB(ctx, r.db)
+	var dbRules []models.FeeRule
+	err := db.WithContext(ctx).Where("schedule_id IN ?", scheduleIDs).Order("id").Find(&dbRules).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.withRules]: Failed to find fee rules: %v", err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	rulesBySchedule := make(map[int64][]models.FeeRule, len(dbSchedules))
+	for _, dbRule := range dbRules {
+		rulesBySchedule[dbRule.ScheduleID] = append(rulesBySchedule[dbRule.ScheduleID], dbRule)
+	}
+
+	schedules := make([]*entity.FeeSchedule, 0, len(dbSchedules))
+	for i := range dbSchedules {
+		schedules = append(schedules, r.mapper.ScheduleToDomain(&dbSchedules[i], rulesBySchedule[dbSchedules[i].ID]))
+	}
+
+	return schedules, nil
+}
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/mapper"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type HoldRepository struct {
+	db     *gorm.DB
+	mapper *mapper.HoldMapper
+}
+
+func NewHoldRepository(db *gorm.DB) *HoldRepository {
+	return &HoldRepository{
+		db:     db,
+		mapper: mapper.NewHoldMapper(),
+	}
+}
+
+// Create creates a new hold
+func (r *HoldRepository) Create(ctx context.Context, hold *entity.Hold) error {
+	db := database.GetDB(ctx, r.db)
+	dbHold := r.mapper.ToModel(hold)
+	err := db.WithContext(ctx).Create(dbHold).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.Create]: Failed to create hold for wallet_id %d: %v", hold.WalletID, err)
+		return apperrors.TranslateError(err)
+	}
+
+	hold.ID = dbHold.ID
+	hold.CreatedAt = dbHold.CreatedAt
+	hold.UpdatedAt = dbHold.UpdatedAt
+
+	return nil
+}
+
+// FindByID retrieves a hold by ID
+func (r *HoldRepository) FindByID(ctx context.Context, id int64) (*entity.Hold, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbHold models.Hold
+	err := db.WithContext(ctx).First(&dbHold, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrHoldNotFound
+		}
+		logger.Error.Printf("[postgres.FindByID]: Failed to find hold by id %d: %v", id, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.ToDomain(&dbHold)
+}
+
+// FindByIDForUpdate retrieves a hold by ID and locks its row until the surrounding transaction ends
+func (r *HoldRepository) FindByIDForUpdate(ctx context.Context, id int64) (*entity.Hold, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbHold models.Hold
+	err := db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&dbHold, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrHoldNotFound
+		}
+		logger.Error.Printf("[postgres.FindByIDForUpdate]: Failed to lock hold by id %d: %v", id, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.ToDomain(&dbHold)
+}
+
+// Update updates an existing hold
+func (r *HoldRepository) Update(ctx context.Context, hold *entity.Hold) error {
+	db := database.GetDB(ctx, r.db)
+	dbHold := r.mapper.ToModel(hold)
+	err := db.WithContext(ctx).Save(dbHold).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.Update]: Failed to update hold id %d: %v", hold.ID, err)
+		return apperrors.TranslateError(err)
+	}
+	return nil
+}
+
+// FindExpired returns active holds past their expiry, except the skipped ones
+func (r *HoldRepository) FindExpired(ctx context.Context, now time.Time, skip []int64, limit int) ([]*entity.Hold, error) {
+	db := database.GetDB(ctx, r.db)
+	query := db.WithContext(ctx).
+		Where("status = ? AND expires_at <= ?", string(entity.HoldStatusActive), now)
+	if len(skip) > 0 {
+		query = query.Where("id NOT IN ?", skip)
+	}
+
+	var dbHolds []models.Hold
+	err := query.
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&dbHolds).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindExpired]: Failed to find expired holds: %v", err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	holds := make([]*entity.Hold, 0, len(dbHolds))
+	for i := range dbHolds {
+		hold, err := r.mapper.ToDomain(&dbHolds[i])
+		if err != nil {
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+
+	return holds, nil
+}
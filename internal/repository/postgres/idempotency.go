@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/mapper"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type IdempotencyRepository struct {
+	db     *gorm.DB
+	mapper *mapper.IdempotencyMapper
+}
+
+func NewIdempotencyRepository(db *gorm.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		db:     db,
+		mapper: mapper.NewIdempotencyMapper(),
+	}
+}
+
+// FindByKey retrieves a stored idempotent response by client and key
+func (r *IdempotencyRepository) FindByKey(ctx context.Context, clientID int64, key string) (*entity.IdempotencyRecord, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbKey models.IdempotencyKey
+	err := db.WithContext(ctx).Where("client_id = ? AND key = ?", clientID, key).First(&dbKey).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrRecordNotFound
+		}
+		logger.Error.Printf("[postgres.FindByKey]: Failed to find idempotency key for client_id %d: %v", clientID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.ToDomain(&dbKey), nil
+}
+
+// Create stores an idempotent response; a duplicate key yields ErrAlreadyExists
+func (r *IdempotencyRepository) Create(ctx context.Context, record *entity.IdempotencyRecord) error {
+	db := database.GetDB(ctx, r.db)
+	dbKey := r.mapper.ToModel(record)
+	err := db.WithContext(ctx).Create(dbKey).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.Create]: Failed to store idempotency key for client_id %d: %v", record.ClientID, err)
+		return apperrors.TranslateError(err)
+	}
+
+	record.ID = dbKey.ID
+	record.CreatedAt = dbKey.CreatedAt
+
+	return nil
+}
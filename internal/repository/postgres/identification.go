@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/mapper"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type IdentificationRepository struct {
+	db     *gorm.DB
+	mapper *mapper.IdentificationMapper
+}
+
+func NewIdentificationRepository(db *gorm.DB) *IdentificationRepository {
+	return &IdentificationRepository{
+		db:     db,
+		mapper: mapper.NewIdentificationMapper(),
+	}
+}
+
+// CreateSubmission stores a new KYC submission
+func (r *IdentificationRepository) CreateSubmission(ctx context.Context, submission *entity.KYCSubmission) error {
+	db := database.GetDB(ctx, r.db)
+	dbSubmission := r.mapper.SubmissionToModel(submission)
+	err := db.WithContext(ctx).Create(dbSubmission).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.CreateSubmission]: Failed to create KYC submission for wallet_id %d: %v", submission.WalletID, err)
+		return apperrors.TranslateError(err)
+	}
+
+	submission.ID = dbSubmission.ID
+	submission.CreatedAt = dbSubmission.CreatedAt
+	submission.UpdatedAt = dbSubmission.UpdatedAt
+
+	return nil
+}
+
+// FindPendingSubmission retrieves the latest pending KYC submission of a wallet
+func (r *IdentificationRepository) FindPendingSubmission(ctx context.Context, walletID int64) (*entity.KYCSubmission, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbSubmission models.KYCSubmission
+	err := db.WithContext(ctx).
+		Where("wallet_id = ? AND status = ?", walletID, string(entity.KYCSubmissionStatusPending)).
+		Order("id DESC").
+		First(&dbSubmission).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNoPendingReview
+		}
+		logger.Error.Printf("[postgres.FindPendingSubmission]: Failed to find KYC submission for wallet_id %d: %v", walletID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.SubmissionToDomain(&dbSubmission), nil
+}
+
+// UpdateSubmission updates an existing KYC submission
+func (r *IdentificationRepository) UpdateSubmission(ctx context.Context, submission *entity.KYCSubmission) error {
+	db := database.GetDB(ctx, r.db)
+	dbSubmission := r.mapper.SubmissionToModel(submission)
+	err := db.WithContext(ctx).Save(dbSubmission).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.UpdateSubmission]: Failed to update KYC submission id %d: %v", submission.ID, err)
+		return apperrors.TranslateError(err)
+	}
+	return nil
+}
+
+// CreateEvent appends an identification status change to the audit trail
+func (r *IdentificationRepository) CreateEvent(ctx context.Context, event *entity.IdentificationEvent) error {
+	db := database.GetDB(ctx, r.db)
+	dbEvent := r.mapper.EventToModel(event)
+	err := db.WithContext(ctx).Create(dbEvent).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.CreateEvent]: Failed to record identification event for wallet_id %d: %v", event.WalletID, err)
+		return apperrors.TranslateError(err)
+	}
+
+	event.ID = dbEvent.ID
+	event.CreatedAt = dbEvent.CreatedAt
+
+	return nil
+}
+
+// FindEventsByWalletID retrieves the identification audit trail of a wallet, oldest first
+func (r *IdentificationRepository) FindEventsByWalletID(ctx context.Context, walletID int64) ([]*entity.IdentificationEvent, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbEvents []models.IdentificationEvent
+	err := db.WithContext(ctx).Where("wallet_id = ?", walletID).Order("id").Find(&dbEvents).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindEventsByWalletID]: Failed to find identification events for wallet_id %d: %v", walletID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	events := make([]*entity.IdentificationEvent, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		events = append(events, r.mapper.EventToDomain(&dbEvent))
+	}
+
+	return events, nil
+}
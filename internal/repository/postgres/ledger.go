@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/mapper"
+	apperrors "e-wallet/pkg/errors"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LedgerRepository struct {
+	db     *gorm.DB
+	mapper *mapper.LedgerMapper
+}
+
+func NewLedgerRepository(db *gorm.DB) *LedgerRepository {
+	return &LedgerRepository{
+		db:     db,
+		mapper: mapper.NewLedgerMapper(),
+	}
+}
+
+// FindOrCreateAccount inserts the account unless its code already exists and returns the stored row
+func (r *LedgerRepository) FindOrCreateAccount(ctx context.Context, account *entity.LedgerAccount) (*entity.LedgerAccount, bool, error) {
+	db := database.GetDB(ctx, r.db)
+	dbAccount := r.mapper.AccountToModel(account)
+	result := db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "code"}}, DoNothing: true}).
+		Create(dbAccount)
+	if result.Error != nil {
+		logger.Error.Printf("[postgres.FindOrCreateAccount]: Failed to create ledger account %s: %v", account.Code, result.Error)
+		return nil, false, apperrors.TranslateError(result.Error)
+	}
+	created := result.RowsAffected == 1
+
+	var stored models.LedgerAccount
+	err := db.WithContext(ctx).Where("code = ?", account.Code).First(&stored).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindOrCreateAccount]: Failed to find ledger account %s: %v", account.Code, err)
+		return nil, false, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.AccountToDomain(&stored), created, nil
+}
+
+func (r *LedgerRepository) FindAccountByID(ctx context.Context, id int64) (*entity.LedgerAccount, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbAccount models.LedgerAccount
+	err := db.WithContext(ctx).First(&dbAccount, id).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindAccountByID]: Failed to find ledger account %d: %v", id, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.AccountToDomain(&dbAccount), nil
+}
+
+func (r *LedgerRepository) FindAccountByIDForUpdate(ctx context.Context, id int64) (*entity.LedgerAccount, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbAccount models.LedgerAccount
+	err := db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&dbAccount, id).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindAccountByIDForUpdate]: Failed to lock ledger account %d: %v", id, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.AccountToDomain(&dbAccount), nil
+}
+
+// CreateEntry must run inside a database transaction so the entry, its postings
+// and the balance updates are committed together
+func (r *LedgerRepository) CreateEntry(ctx context.Context, entry *entity.JournalEntry) error {
+	db := database.GetDB(ctx, r.db)
+
+	dbEntry := r.mapper.EntryToModel(entry)
+	if err := db.WithContext(ctx).Create(dbEntry).Error; err != nil {
+		logger.Error.Printf("[postgres.CreateEntry]: Failed to create journal entry %s: %v", entry.Reference, err)
+		return apperrors.TranslateError(err)
+	}
+	entry.ID = dbEntry.ID
+	entry.CreatedAt = dbEntry.CreatedAt
+
+	dbPostings := make([]*models.LedgerPosting, 0, len(entry.Postings))
+	changes := make(map[int64]int64, len(entry.Postings))
+	for _, posting := range entry.Postings {
+		dbPostings = append(dbPostings, r.mapper.PostingToModel(entry.ID, posting))
+		changes[posting.AccountID] += posting.BalanceChange()
+	}
+	if err := db.WithContext(ctx).Create(dbPostings).Error; err != nil {
+		logger.Error.Printf("[postgres.CreateEntry]: Failed to create postings for journal entry %d: %v", entry.ID, err)
+		return apperrors.TranslateError(err)
+	}
+	for i := range entry.Postings {
+		entry.Postings[i].ID = dbPostings[i].ID
+	}
+
+	// Update accounts in id order so concurrent entries touching the same accounts cannot deadlock
+	accountIDs := make([]int64, 0, len(changes))
+	for accountID := range changes {
+		accountIDs = append(accountIDs, accountID)
+	}
+	sort.Slice(accountIDs, func(i, j int) bool { return accountIDs[i] < accountIDs[j] })
+
+	for _, accountID := range accountIDs {
+		err := db.WithContext(ctx).Model(&models.LedgerAccount{}).
+			Where("id = ?", accountID).
+			Update("balance", gorm.Expr("balance + ?", changes[accountID])).Error
+		if err != nil {
+			logger.Error.Printf("[postgres.CreateEntry]: Failed to update ledger account %d: %v", accountID, err)
+			return apperrors.TranslateError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *LedgerRepository) ExistsEntry(ctx context.Context, kind entity.JournalEntryKind, reference string) (bool, error) {
+	db := database.GetDB(ctx, r.db)
+	var count int64
+	err := db.WithContext(ctx).Model(&models.JournalEntry{}).
+		Where("kind = ? AND reference = ?", string(kind), reference).
+		Count(&count).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.ExistsEntry]: Failed to check %s entry %s: %v", kind, reference, err)
+		return false, apperrors.TranslateError(err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *LedgerRepository) FindMovements(ctx context.Context, filter repository.LedgerMovementFilter) ([]*repository.LedgerMovement, error) {
+	db := database.GetDB(ctx, r.db)
+	query := db.WithContext(ctx).Table("ledger_postings p").
+		Select("p.id AS posting_id, e.kind, e.reference, p.direction, p.amount, p.created_at").
+		Joins("JOIN journal_entries e ON e.id = p.journal_entry_id").
+		Where("p.account_id = ?", filter.AccountID)
+
+	if filter.AfterCreatedAt != nil {
+		query = query.Where("(p.created_at, p.id) < (?, ?)", *filter.AfterCreatedAt, filter.AfterID)
+	}
+
+	var rows []struct {
+		PostingID int64
+		Kind      string
+		Reference string
+		Direction string
+		Amount    int64
+		CreatedAt time.Time
+	}
+	err := query.Order("p.created_at DESC, p.id DESC").Limit(filter.Limit).Scan(&rows).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindMovements]: Failed to find postings of ledger account %d: %v", filter.AccountID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	movements := make([]*repository.LedgerMovement, 0, len(rows))
+	for _, row := range rows {
+		movements = append(movements, &repository.LedgerMovement{
+			PostingID: row.PostingID,
+			Kind:      entity.JournalEntryKind(row.Kind),
+			Reference: row.Reference,
+			Direction: entity.PostingDirection(row.Direction),
+			Amount:    row.Amount,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+
+	return movements, nil
+}
+
+// GetTrialBalance sums account balances per account type
+func (r *LedgerRepository) GetTrialBalance(ctx context.Context) ([]*repository.LedgerTypeBalance, error) {
+	db := database.GetDB(ctx, r.db)
+	var rows []struct {
+		Type     string
+		Accounts int64
+		Balance  int64
+	}
+	err := db.WithContext(ctx).Model(&models.LedgerAccount{}).
+		Select("type, COUNT(*) AS accounts, COALESCE(SUM(balance), 0) AS balance").
+		Group("type").
+		Order("type").
+		Scan(&rows).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.GetTrialBalance]: Failed to aggregate ledger balances: %v", err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	balances := make([]*repository.LedgerTypeBalance, 0, len(rows))
+	for _, row := range rows {
+		balances = append(balances, &repository.LedgerTypeBalance{
+			Type:     entity.LedgerAccountType(row.Type),
+			Accounts: row.Accounts,
+			Balance:  row.Balance,
+		})
+	}
+
+	return balances, nil
+}
+
+func (r *LedgerRepository) FindDriftedAccounts(ctx context.Context) ([]*entity.LedgerAccount, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbAccounts []models.LedgerAccount
+	err := db.WithContext(ctx).
+		Where(`balance <> COALESCE((
+			SELECT SUM(CASE WHEN p.direction = 'credit' THEN p.amount ELSE -p.amount END)
+			FROM ledger_postings p
+			WHERE p.account_id = ledger_accounts.id
+		), 0)`).
+		Order("id").
+		Find(&dbAccounts).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindDriftedAccounts]: Failed to check ledger accounts: %v", err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	accounts := make([]*entity.LedgerAccount, 0, len(dbAccounts))
+	for _, dbAccount := range dbAccounts {
+		accounts = append(accounts, r.mapper.AccountToDomain(&dbAccount))
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type NonceRepository struct {
+	db *gorm.DB
+}
+
+func NewNonceRepository(db *gorm.DB) *NonceRepository {
+	return &NonceRepository{
+		db: db,
+	}
+}
+
+// Create stores a nonce for a client
+func (r *NonceRepository) Create(ctx context.Context, clientID int64, nonce string) error {
+	db := database.GetDB(ctx, r.db)
+	err := db.WithContext(ctx).Create(&models.RequestNonce{ClientID: clientID, Nonce: nonce}).Error
+	if err != nil {
+		return apperrors.TranslateError(err)
+	}
+	return nil
+}
+
+// DeleteOlderThan removes nonces that can no longer be replayed
+func (r *NonceRepository) DeleteOlderThan(ctx context.Context, before time.Time) error {
+	db := database.GetDB(ctx, r.db)
+	err := db.WithContext(ctx).Where("created_at < ?", before).Delete(&models.RequestNonce{}).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.DeleteOlderThan]: Failed to delete nonces older than %s: %v", before, err)
+		return apperrors.TranslateError(err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/mapper"
+	apperrors "e-wallet/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+type ReconciliationRepository struct {
+	db     *gorm.DB
+	mapper *mapper.ReconciliationMapper
+}
+
+func NewReconciliationRepository(db *gorm.DB) *ReconciliationRepository {
+	return &ReconciliationRepository{
+		db:     db,
+		mapper: mapper.NewReconciliationMapper(),
+	}
+}
+
+func (r *ReconciliationRepository) ComputeWalletBalances(ctx context.Context) ([]*repository.WalletBalanceCheck, error) {
+	db := database.GetDB(ctx, r.db)
+	var rows []struct {
+		WalletID        int64
+		AccountID       string
+		StoredBalance   int64
+		ComputedBalance int64
+	}
+	err := db.WithContext(ctx).Table("wallets w").
+		Select(`w.id AS wallet_id, w.account_id, w.balance AS stored_balance,
+			COALESCE(SUM(CASE
+				WHEN t.type IN ? THEN t.amount
+				WHEN t.type IN ? THEN -t.amount
+				ELSE 0
+			END), 0) AS computed_balance`,
+			transactionTypeNames(entity.CreditTransactionTypes),
+			transactionTypeNames(entity.DebitTransactionTypes)).
+		Joins("LEFT JOIN transactions t ON t.wallet_id = w.id").
+		Group("w.id").
+		Order("w.id").
+		Scan(&rows).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.ComputeWalletBalances]: Failed to recompute wallet balances: %v", err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	checks := make([]*repository.WalletBalanceCheck, 0, len(rows))
+	for _, row := range rows {
+		checks = append(checks, &repository.WalletBalanceCheck{
+			WalletID:        row.WalletID,
+			AccountID:       row.AccountID,
+			StoredBalance:   row.StoredBalance,
+			ComputedBalance: row.ComputedBalance,
+		})
+	}
+
+	return checks, nil
+}
+
+func (r *ReconciliationRepository) CreateRun(ctx context.Context, run *entity.ReconciliationRun) error {
+	db := database.GetDB(ctx, r.db)
+	dbRun := r.mapper.RunToModel(run)
+	if err := db.WithContext(ctx).Create(dbRun).Error; err != nil {
+		logger.Error.Printf("[postgres.CreateRun]: Failed to create reconciliation run: %v", err)
+		return apperrors.TranslateError(err)
+	}
+	run.ID = dbRun.ID
+
+	if len(run.Discrepancies) == 0 {
+		return nil
+	}
+
+	dbDiscrepancies := make([]*models.ReconciliationDiscrepancy, 0, len(run.Discrepancies))
+	for _, discrepancy := range run.Discrepancies {
+		dbDiscrepancies = append(dbDiscrepancies, r.mapper.DiscrepancyToModel(run.ID, discrepancy))
+	}
+	if err := db.WithContext(ctx).Create(dbDiscrepancies).Error; err != nil {
+		logger.Error.Printf("[postgres.CreateRun]: Failed to store discrepancies of run %d: %v", run.ID, err)
+		return apperrors.TranslateError(err)
+	}
+
+	for i, discrepancy := range run.Discrepancies {
+		discrepancy.ID = dbDiscrepancies[i].ID
+		discrepancy.RunID = run.ID
+		discrepancy.CreatedAt = dbDiscrepancies[i].CreatedAt
+	}
+
+	return nil
+}
+
+func transactionTypeNames(types []entity.TransactionType) []string {
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, string(t))
+	}
+	return names
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/mapper"
+	apperrors "e-wallet/pkg/errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// businessDateLayout formats business dates as DATE literals, so they compare without a time zone
+const businessDateLayout = "2006-01-02"
+
+type SettlementRepository struct {
+	db     *gorm.DB
+	mapper *mapper.SettlementMapper
+}
+
+func NewSettlementRepository(db *gorm.DB) *SettlementRepository {
+	return &SettlementRepository{
+		db:     db,
+		mapper: mapper.NewSettlementMapper(),
+	}
+}
+
+func (r *SettlementRepository) AggregateDay(ctx context.Context, from, to time.Time) ([]*repository.SettlementTotal, error) {
+	db := database.GetDB(ctx, r.db)
+	var totals []*repository.SettlementTotal
+	err := db.WithContext(ctx).Table("api_clients c").
+		Select("c.id AS client_id, COALESCE(t.type, '') AS type, COUNT(t.id) AS count, COALESCE(SUM(t.amount), 0) AS amount").
+		Joins("LEFT JOIN transactions t ON t.client_id = c.id AND t.created_at >= ? AND t.created_at < ?", from, to).
+		Where("c.is_admin = ? AND c.created_at < ?", false, to).
+		Group("c.id, t.type").
+		Order("c.id, t.type").
+		Scan(&totals).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.AggregateDay]: Failed to aggregate transactions from %s to %s: %v", from, to, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return totals, nil
+}
+
+func (r *SettlementRepository) CreateMissing(ctx context.Context, settlements []*entity.Settlement) (int64, error) {
+	if len(settlements) == 0 {
+		return 0, nil
+	}
+
+	dbSettlements := make([]*models.Settlement, 0, len(settlements))
+	for _, settlement := range settlements {
+		dbSettlements = append(dbSettlements, r.mapper.ToModel(settlement))
+	}
+
+	db := database.GetDB(ctx, r.db)
+	result := db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "client_id"}, {Name: "business_date"}}, DoNothing: true}).
+		Create(dbSettlements)
+	if result.Error != nil {
+		logger.Error.Printf("[postgres.CreateMissing]: Failed to create settlements: %v", result.Error)
+		return 0, apperrors.TranslateError(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *SettlementRepository) FindByClient(ctx context.Context, clientID int64, from, to time.Time) ([]*entity.Settlement, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbSettlements []models.Settlement
+	err := db.WithContext(ctx).
+		Where("client_id = ? AND business_date BETWEEN ?::date AND ?::date", clientID, from.Format(businessDateLayout), to.Format(businessDateLayout)).
+		Order("business_date").
+		Find(&dbSettlements).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindByClient]: Failed to find settlements for client_id %d: %v", clientID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.toDomain(dbSettlements), nil
+}
+
+func (r *SettlementRepository) FindByDate(ctx context.Context, businessDate time.Time) ([]*entity.Settlement, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbSettlements []models.Settlement
+	err := db.WithContext(ctx).
+		Where("business_date = ?::date", businessDate.Format(businessDateLayout)).
+		Order("client_id").
+		Find(&dbSettlements).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindByDate]: Failed to find settlements for %s: %v", businessDate.Format(businessDateLayout), err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.toDomain(dbSettlements), nil
+}
+
+func (r *SettlementRepository) toDomain(dbSettlements []models.Settlement) []*entity.Settlement {
+	settlements := make([]*entity.Settlement, 0, len(dbSettlements))
+	for i := range dbSettlements {
+		settlements = append(settlements, r.mapper.ToDomain(&dbSettlements[i]))
+	}
+	return settlements
+}
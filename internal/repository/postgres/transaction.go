@@ -9,9 +9,11 @@ import (
 	"e-wallet/internal/infrastructure/logger"
 	"e-wallet/internal/repository/mapper"
 	apperrors "e-wallet/pkg/errors"
+	"errors"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type TransactionRepository struct {
@@ -41,12 +43,140 @@ func (r *TransactionRepository) Create(ctx context.Context, transaction *entity.
 	return nil
 }
 
-func (r *TransactionRepository) FindByWalletID(ctx context.Context, walletID int64) ([]*entity.Transaction, error) {
+// FindByID retrieves a transaction by ID
+func (r *TransactionRepository) FindByID(ctx context.Context, id int64) (*entity.Transaction, error) {
 	db := database.GetDB(ctx, r.db)
+	var dbTx models.Transaction
+	err := db.WithContext(ctx).Where("id = ?", id).First(&dbTx).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrTransactionNotFound
+		}
+		logger.Error.Printf("[postgres.FindByID]: Failed to find transaction by id %d: %v", id, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.ToDomain(&dbTx)
+}
+
+// FindByIDForUpdate retrieves a transaction by ID and locks its row
+func (r *TransactionRepository) FindByIDForUpdate(ctx context.Context, id int64) (*entity.Transaction, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbTx models.Transaction
+	err := db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&dbTx).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrTransactionNotFound
+		}
+		logger.Error.Printf("[postgres.FindByIDForUpdate]: Failed to lock transaction by id %d: %v", id, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.ToDomain(&dbTx)
+}
+
+// FindByExternalID retrieves a transaction by the client's external ID
+func (r *TransactionRepository) FindByExternalID(ctx context.Context, clientID int64, externalID string) (*entity.Transaction, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbTx models.Transaction
+	err := db.WithContext(ctx).Where("client_id = ? AND external_id = ?", clientID, externalID).First(&dbTx).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrTransactionNotFound
+		}
+		logger.Error.Printf("[postgres.FindByExternalID]: Failed to find transaction of client_id %d by external id: %v", clientID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.ToDomain(&dbTx)
+}
+
+// UpdateStatus saves only the status columns, the rest of a transaction never changes
+func (r *TransactionRepository) UpdateStatus(ctx context.Context, transaction *entity.Transaction) error {
+	db := database.GetDB(ctx, r.db)
+	err := db.WithContext(ctx).Model(&models.Transaction{}).
+		Where("id = ?", transaction.ID).
+		Updates(map[string]interface{}{
+			"status":          string(transaction.Status),
+			"reversed_amount": transaction.ReversedAmount.Amount(),
+			"updated_at":      transaction.UpdatedAt,
+		}).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.UpdateStatus]: Failed to update status of transaction %d: %v", transaction.ID, err)
+		return apperrors.TranslateError(err)
+	}
+
+	return nil
+}
+
+// FindFee retrieves the fee transaction of an operation
+func (r *TransactionRepository) FindFee(ctx context.Context, walletID int64, reference string) (*entity.Transaction, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbTx models.Transaction
+	err := db.WithContext(ctx).
+		Where("wallet_id = ? AND reference = ? AND type = ?", walletID, reference, string(entity.TransactionTypeFee)).
+		First(&dbTx).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrTransactionNotFound
+		}
+		logger.Error.Printf("[postgres.FindFee]: Failed to find fee of operation %s: %v", reference, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.ToDomain(&dbTx)
+}
+
+// FindReversals retrieves the reversals of a transaction, oldest first
+func (r *TransactionRepository) FindReversals(ctx context.Context, originalID int64) ([]*entity.Transaction, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbTxs []models.Transaction
+	err := db.WithContext(ctx).Where("original_transaction_id = ?", originalID).Order("id").Find(&dbTxs).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindReversals]: Failed to find reversals of transaction %d: %v", originalID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	reversals := make([]*entity.Transaction, 0, len(dbTxs))
+	for i := range dbTxs {
+		reversal, err := r.mapper.ToDomain(&dbTxs[i])
+		if err != nil {
+			return nil, err
+		}
+		reversals = append(reversals, reversal)
+	}
+
+	return reversals, nil
+}
+
+// FindPage returns one page of a wallet's transactions ordered by (created_at, id) descending
+func (r *TransactionRepository) FindPage(ctx context.Context, filter repository.TransactionFilter) ([]*entity.Transaction, error) {
+	db := database.GetDB(ctx, r.db)
+	query := db.WithContext(ctx).Where("wallet_id = ?", filter.WalletID)
+
+	if len(filter.Types) > 0 {
+		query = query.Where("type IN ?", transactionTypeNames(filter.Types))
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+	if filter.MinAmount != nil {
+		query = query.Where("amount >= ?", *filter.MinAmount)
+	}
+	if filter.MaxAmount != nil {
+		query = query.Where("amount <= ?", *filter.MaxAmount)
+	}
+	if filter.AfterCreatedAt != nil {
+		query = query.Where("(created_at, id) < (?, ?)", *filter.AfterCreatedAt, filter.AfterID)
+	}
+
 	var dbTransactions []models.Transaction
-	err := db.WithContext(ctx).Where("wallet_id = ?", walletID).Order("created_at DESC").Find(&dbTransactions).Error
+	err := query.Order("created_at DESC, id DESC").Limit(filter.Limit).Find(&dbTransactions).Error
 	if err != nil {
-		logger.Error.Printf("[postgres.FindByWalletID]: Failed to find transactions for wallet_id %d: %v", walletID, err)
+		logger.Error.Printf("[postgres.FindPage]: Failed to find transactions for wallet_id %d: %v", filter.WalletID, err)
 		return nil, apperrors.TranslateError(err)
 	}
 
@@ -62,24 +192,57 @@ func (r *TransactionRepository) FindByWalletID(ctx context.Context, walletID int
 	return transactions, nil
 }
 
-// GetMonthlyStats retrieves monthly statistics for a wallet
-func (r *TransactionRepository) GetMonthlyStats(ctx context.Context, walletID int64, month time.Time) (*repository.MonthlyStats, error) {
-	firstDay := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
-	lastDay := firstDay.AddDate(0, 1, 0).Add(-time.Second)
-
+// GetPeriodStats aggregates a wallet's transactions in [from, to) by bucket and type.
+// Buckets start at midnight in loc rather than in the database session time zone. A week that
+// begins before from only counts its days in the range, so its period is reported as from.
+func (r *TransactionRepository) GetPeriodStats(ctx context.Context, walletID int64, from, to time.Time, groupBy repository.StatsGrouping, loc *time.Location) ([]*repository.PeriodStats, error) {
 	db := database.GetDB(ctx, r.db)
-	var stats repository.MonthlyStats
+	var stats []*repository.PeriodStats
 	err := db.WithContext(ctx).
 		Model(&models.Transaction{}).
-		Select("COUNT(*) as total_count, COALESCE(SUM(amount), 0) as total_amount").
-		Where("wallet_id = ? AND type = ? AND created_at BETWEEN ? AND ?",
-			walletID, string(entity.TransactionTypeDeposit), firstDay, lastDay).
+		Select("date_trunc(?, created_at, ?) AS period, type, COUNT(*) AS total_count, COALESCE(SUM(amount), 0) AS total_amount", string(groupBy), loc.String()).
+		Where("wallet_id = ? AND created_at >= ? AND created_at < ?", walletID, from, to).
+		Group("period, type").
+		Order("period, type").
 		Scan(&stats).Error
 
 	if err != nil {
-		logger.Error.Printf("[postgres.GetMonthlyStats]: Failed to get monthly stats for wallet_id %d: %v", walletID, err)
+		logger.Error.Printf("[postgres.GetPeriodStats]: Failed to get stats for wallet_id %d: %v", walletID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	for _, row := range stats {
+		row.Period = row.Period.In(loc)
+		if row.Period.Before(from) {
+			row.Period = from.In(loc)
+		}
+	}
+
+	return stats, nil
+}
+
+// GetTurnover aggregates in one statement so the daily and monthly figures are read from the same snapshot
+func (r *TransactionRepository) GetTurnover(ctx context.Context, walletID int64, types []entity.TransactionType, dayStart, monthStart time.Time) (*repository.Turnover, error) {
+	since := monthStart
+	if dayStart.Before(since) {
+		since = dayStart
+	}
+
+	db := database.GetDB(ctx, r.db)
+	var turnover repository.Turnover
+	err := db.WithContext(ctx).
+		Model(&models.Transaction{}).
+		Select(`COUNT(*) FILTER (WHERE created_at >= ?) AS daily_count,
+			COALESCE(SUM(amount) FILTER (WHERE created_at >= ?), 0) AS daily_amount,
+			COUNT(*) FILTER (WHERE created_at >= ?) AS monthly_count,
+			COALESCE(SUM(amount) FILTER (WHERE created_at >= ?), 0) AS monthly_amount`,
+			dayStart, dayStart, monthStart, monthStart).
+		Where("wallet_id = ? AND type IN ? AND created_at >= ?", walletID, transactionTypeNames(types), since).
+		Scan(&turnover).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.GetTurnover]: Failed to get turnover for wallet_id %d: %v", walletID, err)
 		return nil, apperrors.TranslateError(err)
 	}
 
-	return &stats, nil
+	return &turnover, nil
 }
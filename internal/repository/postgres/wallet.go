@@ -12,6 +12,7 @@ import (
 	"errors"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type WalletRepository struct {
@@ -26,16 +27,75 @@ func NewWalletRepository(db *gorm.DB) *WalletRepository {
 	}
 }
 
-// FindByAccountID retrieves a wallet by account ID
+// FindByAccountID retrieves a wallet by account ID whatever partner owns it.
+// Returns ErrWalletAmbiguous when several partners hold a wallet with that account ID.
 func (r *WalletRepository) FindByAccountID(ctx context.Context, accountID valueobject.AccountID) (*entity.Wallet, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbWallets []models.Wallet
+	err := db.WithContext(ctx).Where("account_id = ?", accountID.Value()).Order("id").Limit(2).Find(&dbWallets).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindByAccountID]: Failed to find wallet by account_id %s: %v", accountID.Value(), err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.single(dbWallets)
+}
+
+// FindByAccountIDForUpdate is FindByAccountID that locks the wallet row until the surrounding transaction ends
+func (r *WalletRepository) FindByAccountIDForUpdate(ctx context.Context, accountID valueobject.AccountID) (*entity.Wallet, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbWallets []models.Wallet
+	err := db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("account_id = ?", accountID.Value()).Order("id").Limit(2).Find(&dbWallets).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindByAccountIDForUpdate]: Failed to lock wallet by account_id %s: %v", accountID.Value(), err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.single(dbWallets)
+}
+
+// single maps the only wallet of a lookup by account ID
+func (r *WalletRepository) single(dbWallets []models.Wallet) (*entity.Wallet, error) {
+	switch len(dbWallets) {
+	case 0:
+		return nil, apperrors.ErrWalletNotFound
+	case 1:
+		return r.mapper.ToDomain(&dbWallets[0])
+	default:
+		return nil, apperrors.ErrWalletAmbiguous
+	}
+}
+
+// FindOwnedByAccountID retrieves the wallet with the account ID among those owned by the partner.
+// Wallets of other partners are reported as ErrWalletNotFound, so partners cannot probe which accounts exist.
+func (r *WalletRepository) FindOwnedByAccountID(ctx context.Context, ownerClientID int64, accountID valueobject.AccountID) (*entity.Wallet, error) {
 	db := database.GetDB(ctx, r.db)
 	var dbWallet models.Wallet
-	err := db.WithContext(ctx).Where("account_id = ?", accountID.Value()).First(&dbWallet).Error
+	err := db.WithContext(ctx).Where("owner_client_id = ? AND account_id = ?", ownerClientID, accountID.Value()).
+		First(&dbWallet).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, apperrors.ErrWalletNotFound
 		}
-		logger.Error.Printf("[postgres.FindByAccountID]: Failed to find wallet by account_id %s: %v", accountID.Value(), err)
+		logger.Error.Printf("[postgres.FindOwnedByAccountID]: Failed to find wallet %s of client_id %d: %v", accountID.Value(), ownerClientID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.ToDomain(&dbWallet)
+}
+
+// FindOwnedByAccountIDForUpdate retrieves the partner's wallet by account ID and locks its row until the surrounding transaction ends
+func (r *WalletRepository) FindOwnedByAccountIDForUpdate(ctx context.Context, ownerClientID int64, accountID valueobject.AccountID) (*entity.Wallet, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbWallet models.Wallet
+	err := db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("owner_client_id = ? AND account_id = ?", ownerClientID, accountID.Value()).First(&dbWallet).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrWalletNotFound
+		}
+		logger.Error.Printf("[postgres.FindOwnedByAccountIDForUpdate]: Failed to lock wallet %s of client_id %d: %v", accountID.Value(), ownerClientID, err)
 		return nil, apperrors.TranslateError(err)
 	}
 
@@ -58,6 +118,22 @@ func (r *WalletRepository) FindByID(ctx context.Context, id int64) (*entity.Wall
 	return r.mapper.ToDomain(&dbWallet)
 }
 
+// FindByIDForUpdate retrieves a wallet by ID and locks its row until the surrounding transaction ends
+func (r *WalletRepository) FindByIDForUpdate(ctx context.Context, id int64) (*entity.Wallet, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbWallet models.Wallet
+	err := db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&dbWallet, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrWalletNotFound
+		}
+		logger.Error.Printf("[postgres.FindByIDForUpdate]: Failed to lock wallet by id %d: %v", id, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.ToDomain(&dbWallet)
+}
+
 // Create creates a new wallet
 func (r *WalletRepository) Create(ctx context.Context, wallet *entity.Wallet) error {
 	db := database.GetDB(ctx, r.db)
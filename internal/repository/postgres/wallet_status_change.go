@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/mapper"
+	apperrors "e-wallet/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+type WalletStatusChangeRepository struct {
+	db     *gorm.DB
+	mapper *mapper.WalletStatusChangeMapper
+}
+
+func NewWalletStatusChangeRepository(db *gorm.DB) *WalletStatusChangeRepository {
+	return &WalletStatusChangeRepository{
+		db:     db,
+		mapper: mapper.NewWalletStatusChangeMapper(),
+	}
+}
+
+// Create appends a status change to the audit trail
+func (r *WalletStatusChangeRepository) Create(ctx context.Context, change *entity.WalletStatusChange) error {
+	db := database.GetDB(ctx, r.db)
+	dbChange := r.mapper.ToModel(change)
+	err := db.WithContext(ctx).Create(dbChange).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.Create]: Failed to record status change for wallet_id %d: %v", change.WalletID, err)
+		return apperrors.TranslateError(err)
+	}
+
+	change.ID = dbChange.ID
+	change.CreatedAt = dbChange.CreatedAt
+
+	return nil
+}
+
+// FindByWalletID retrieves the status audit trail of a wallet, oldest first
+func (r *WalletStatusChangeRepository) FindByWalletID(ctx context.Context, walletID int64) ([]*entity.WalletStatusChange, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbChanges []models.WalletStatusChange
+	err := db.WithContext(ctx).Where("wallet_id = ?", walletID).Order("id").Find(&dbChanges).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.FindByWalletID]: Failed to find status changes for wallet_id %d: %v", walletID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	changes := make([]*entity.WalletStatusChange, 0, len(dbChanges))
+	for _, dbChange := range dbChanges {
+		changes = append(changes, r.mapper.ToDomain(&dbChange))
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/mapper"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type WebhookOutboxRepository struct {
+	db     *gorm.DB
+	mapper *mapper.WebhookMapper
+}
+
+func NewWebhookOutboxRepository(db *gorm.DB) *WebhookOutboxRepository {
+	return &WebhookOutboxRepository{
+		db:     db,
+		mapper: mapper.NewWebhookMapper(),
+	}
+}
+
+// Create writes a delivery to the outbox
+func (r *WebhookOutboxRepository) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	db := database.GetDB(ctx, r.db)
+	dbDelivery := r.mapper.DeliveryToModel(delivery)
+	err := db.WithContext(ctx).Create(dbDelivery).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.Create]: Failed to write %s event %s to the outbox: %v", delivery.EventType, delivery.EventID, err)
+		return apperrors.TranslateError(err)
+	}
+
+	delivery.ID = dbDelivery.ID
+	delivery.CreatedAt = dbDelivery.CreatedAt
+	delivery.UpdatedAt = dbDelivery.UpdatedAt
+
+	return nil
+}
+
+// Update updates an existing delivery
+func (r *WebhookOutboxRepository) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	db := database.GetDB(ctx, r.db)
+	dbDelivery := r.mapper.DeliveryToModel(delivery)
+	err := db.WithContext(ctx).Save(dbDelivery).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.Update]: Failed to update webhook delivery id %d: %v", delivery.ID, err)
+		return apperrors.TranslateError(err)
+	}
+	return nil
+}
+
+// FindByID retrieves a delivery by ID
+func (r *WebhookOutboxRepository) FindByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbDelivery models.WebhookDelivery
+	err := db.WithContext(ctx).First(&dbDelivery, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrDeliveryNotFound
+		}
+		logger.Error.Printf("[postgres.FindByID]: Failed to find webhook delivery by id %d: %v", id, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.DeliveryToDomain(&dbDelivery), nil
+}
+
+// FindByClient retrieves the client's latest deliveries
+func (r *WebhookOutboxRepository) FindByClient(ctx context.Context, clientID int64, status entity.WebhookDeliveryStatus, limit int) ([]*entity.WebhookDelivery, error) {
+	db := database.GetDB(ctx, r.db)
+	query := db.WithContext(ctx).Where("client_id = ?", clientID)
+	if status != "" {
+		query = query.Where("status = ?", string(status))
+	}
+
+	var dbDeliveries []models.WebhookDelivery
+	if err := query.Order("id DESC").Limit(limit).Find(&dbDeliveries).Error; err != nil {
+		logger.Error.Printf("[postgres.FindByClient]: Failed to find webhook deliveries for client_id %d: %v", clientID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.toDomainList(dbDeliveries), nil
+}
+
+// ClaimDue leases due pending deliveries. SKIP LOCKED lets several workers claim disjoint batches.
+func (r *WebhookOutboxRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.WebhookDelivery, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbDeliveries []models.WebhookDelivery
+	err := db.WithContext(ctx).Raw(`
+		UPDATE webhook_outbox SET next_attempt_at = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_outbox
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, string(entity.WebhookDeliveryPending), now, limit,
+	).Scan(&dbDeliveries).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.ClaimDue]: Failed to claim due webhook deliveries: %v", err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.toDomainList(dbDeliveries), nil
+}
+
+func (r *WebhookOutboxRepository) toDomainList(dbDeliveries []models.WebhookDelivery) []*entity.WebhookDelivery {
+	deliveries := make([]*entity.WebhookDelivery, len(dbDeliveries))
+	for i := range dbDeliveries {
+		deliveries[i] = r.mapper.DeliveryToDomain(&dbDeliveries[i])
+	}
+	return deliveries
+}
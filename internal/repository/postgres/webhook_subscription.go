@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/mapper"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type WebhookSubscriptionRepository struct {
+	db     *gorm.DB
+	mapper *mapper.WebhookMapper
+}
+
+func NewWebhookSubscriptionRepository(db *gorm.DB) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{
+		db:     db,
+		mapper: mapper.NewWebhookMapper(),
+	}
+}
+
+// Create creates a new webhook subscription
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	db := database.GetDB(ctx, r.db)
+	dbSubscription := r.mapper.SubscriptionToModel(subscription)
+	err := db.WithContext(ctx).Create(dbSubscription).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.Create]: Failed to create webhook subscription for client_id %d: %v", subscription.ClientID, err)
+		return apperrors.TranslateError(err)
+	}
+
+	subscription.ID = dbSubscription.ID
+	subscription.CreatedAt = dbSubscription.CreatedAt
+	subscription.UpdatedAt = dbSubscription.UpdatedAt
+
+	return nil
+}
+
+// Update updates an existing webhook subscription
+func (r *WebhookSubscriptionRepository) Update(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	db := database.GetDB(ctx, r.db)
+	dbSubscription := r.mapper.SubscriptionToModel(subscription)
+	err := db.WithContext(ctx).Save(dbSubscription).Error
+	if err != nil {
+		logger.Error.Printf("[postgres.Update]: Failed to update webhook subscription id %d: %v", subscription.ID, err)
+		return apperrors.TranslateError(err)
+	}
+	return nil
+}
+
+// FindByID retrieves a webhook subscription by ID
+func (r *WebhookSubscriptionRepository) FindByID(ctx context.Context, id int64) (*entity.WebhookSubscription, error) {
+	db := database.GetDB(ctx, r.db)
+	var dbSubscription models.WebhookSubscription
+	err := db.WithContext(ctx).First(&dbSubscription, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrSubscriptionNotFound
+		}
+		logger.Error.Printf("[postgres.FindByID]: Failed to find webhook subscription by id %d: %v", id, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	return r.mapper.SubscriptionToDomain(&dbSubscription), nil
+}
+
+// FindByClient retrieves the client's webhook subscriptions
+func (r *WebhookSubscriptionRepository) FindByClient(ctx context.Context, clientID int64, activeOnly bool) ([]*entity.WebhookSubscription, error) {
+	db := database.GetDB(ctx, r.db)
+	query := db.WithContext(ctx).Where("client_id = ?", clientID)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	var dbSubscriptions []models.WebhookSubscription
+	if err := query.Order("id ASC").Find(&dbSubscriptions).Error; err != nil {
+		logger.Error.Printf("[postgres.FindByClient]: Failed to find webhook subscriptions for client_id %d: %v", clientID, err)
+		return nil, apperrors.TranslateError(err)
+	}
+
+	subscriptions := make([]*entity.WebhookSubscription, len(dbSubscriptions))
+	for i := range dbSubscriptions {
+		subscriptions[i] = r.mapper.SubscriptionToDomain(&dbSubscriptions[i])
+	}
+
+	return subscriptions, nil
+}
@@ -26,6 +26,9 @@ func (r *CacheRepository) Get(ctx context.Context, key string) (string, error) {
 func (r *CacheRepository) Set(ctx context.Context, key string, value string, expiration time.Duration) error {
 	return r.client.Set(ctx, key, value, expiration)
 }
+func (r *CacheRepository) SetNX(ctx context.Context, key string, value string, expiration time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, key, value, expiration)
+}
 func (r *CacheRepository) Delete(ctx context.Context, key string) error {
 	return r.client.Delete(ctx, key)
 }
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+	"time"
+)
+
+// FeeQuote is the fee priced for one operation; Rule is nil when the operation is free
+type FeeQuote struct {
+	Fee  valueobject.Money
+	Rule *entity.FeeRule
+}
+
+// FeeUseCase prices operations with the partner's fee schedule and charges the fees.
+// Like LedgerUseCase it is called inside the database transaction of the operation.
+type FeeUseCase struct {
+	feeRepo         repository.FeeScheduleRepository
+	transactionRepo repository.TransactionRepository
+	ledger          *LedgerUseCase
+}
+
+func NewFeeUseCase(
+	feeRepo repository.FeeScheduleRepository,
+	transactionRepo repository.TransactionRepository,
+	ledger *LedgerUseCase,
+) *FeeUseCase {
+	return &FeeUseCase{
+		feeRepo:         feeRepo,
+		transactionRepo: transactionRepo,
+		ledger:          ledger,
+	}
+}
+
+// Quote prices the operation with the schedule of the client in effect now.
+// The type of the operation's wallet selects wallet-specific rules.
+func (uc *FeeUseCase) Quote(ctx context.Context, clientID int64, operation entity.FeeOperation, wallet *entity.Wallet, amount valueobject.Money) (*FeeQuote, error) {
+	schedule, err := uc.feeRepo.FindEffective(ctx, clientID, time.Now())
+	if err != nil {
+		if errors.Is(err, apperrors.ErrRecordNotFound) {
+			return &FeeQuote{}, nil
+		}
+		return nil, err
+	}
+
+	rule := schedule.RuleFor(operation, wallet.Type, amount)
+	if rule == nil {
+		return &FeeQuote{}, nil
+	}
+
+	// A fee that takes the whole amount or more means the schedule is misconfigured
+	fee := rule.Compute(amount)
+	if !fee.IsLessThan(amount) {
+		return nil, apperrors.ErrFeeExceedsAmount
+	}
+
+	return &FeeQuote{Fee: fee, Rule: rule}, nil
+}
+
+// Charge takes a quoted fee from the partner's float into fee revenue and records it as a fee
+// transaction sharing the operation's reference. The fee is the partner's commission, so the
+// customer wallet is not debited: the transaction is kept on the operation's wallet only to explain
+// the operation and records its unchanged balance. It returns nil for free operations.
+func (uc *FeeUseCase) Charge(
+	ctx context.Context,
+	wallet *entity.Wallet,
+	clientID int64,
+	reference string,
+	quote *FeeQuote,
+) (*entity.Transaction, error) {
+	if quote.Fee.Amount() == 0 {
+		return nil, nil
+	}
+
+	floatAccount, err := uc.ledger.DrawPartnerFloat(ctx, clientID, quote.Fee)
+	if err != nil {
+		return nil, err
+	}
+	revenueAccount, err := uc.ledger.SystemAccount(ctx, entity.LedgerAccountCodeFeeRevenue, entity.LedgerAccountTypeFeeRevenue)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := entity.NewTransaction(wallet.ID, clientID, entity.TransactionTypeFee, quote.Fee, wallet.Balance)
+	transaction.Reference = reference
+	transaction.FeeRuleID = &quote.Rule.ID
+	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ledger.Move(ctx, entity.JournalEntryKindFee, reference, floatAccount, revenueAccount, quote.Fee); err != nil {
+		return nil, err
+	}
+
+	logger.Info.Printf("[usecase.Charge]: Charged fee of %d dirams to the float of client_id %d for wallet_id %d (rule %d, reference %s)",
+		quote.Fee.Dirams(), clientID, wallet.ID, quote.Rule.ID, reference)
+
+	return transaction, nil
+}
+
+// Refund returns the fee of a reversed operation in proportion to the part reversed so far, as a
+// fee_refund transaction from fee revenue back to the partner's float. The operation's ReversedAmount
+// must already include the reversal, and the reference is the reversal's. It returns nil when the
+// operation was free or nothing is owed back yet.
+func (uc *FeeUseCase) Refund(
+	ctx context.Context,
+	wallet *entity.Wallet,
+	clientID int64,
+	reference string,
+	operation *entity.Transaction,
+) (*entity.Transaction, error) {
+	fee, err := uc.transactionRepo.FindFee(ctx, wallet.ID, operation.Reference)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrTransactionNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	refund := operation.FeeRefund(fee)
+	if refund.Amount() == 0 {
+		return nil, nil
+	}
+
+	revenueAccount, err := uc.ledger.SystemAccount(ctx, entity.LedgerAccountCodeFeeRevenue, entity.LedgerAccountTypeFeeRevenue)
+	if err != nil {
+		return nil, err
+	}
+	floatAccount, err := uc.ledger.PartnerFloatAccount(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fee.ApplyReversal(refund); err != nil {
+		return nil, err
+	}
+	if err := uc.transactionRepo.UpdateStatus(ctx, fee); err != nil {
+		return nil, err
+	}
+
+	transaction := entity.NewTransaction(wallet.ID, clientID, entity.TransactionTypeFeeRefund, refund, wallet.Balance)
+	transaction.Reference = reference
+	transaction.OriginalTransactionID = &fee.ID
+	transaction.FeeRuleID = fee.FeeRuleID
+	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ledger.Move(ctx, entity.JournalEntryKindFeeRefund, reference, revenueAccount, floatAccount, refund); err != nil {
+		return nil, err
+	}
+
+	logger.Info.Printf("[usecase.Refund]: Refunded %d of %d dirams fee %d to the float of client_id %d (reference %s)",
+		refund.Dirams(), fee.Amount.Dirams(), fee.ID, clientID, reference)
+
+	return transaction, nil
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// feeScheduleClockSkew tolerates an effective date a little behind the server clock
+const feeScheduleClockSkew = time.Minute
+
+// FeeScheduleCreateUseCase handles admin creation of fee schedule versions
+type FeeScheduleCreateUseCase struct {
+	db         *gorm.DB
+	clientRepo repository.ClientRepository
+	feeRepo    repository.FeeScheduleRepository
+}
+
+// NewFeeScheduleCreateUseCase creates a new FeeScheduleCreateUseCase
+func NewFeeScheduleCreateUseCase(
+	db *gorm.DB,
+	clientRepo repository.ClientRepository,
+	feeRepo repository.FeeScheduleRepository,
+) *FeeScheduleCreateUseCase {
+	return &FeeScheduleCreateUseCase{
+		db:         db,
+		clientRepo: clientRepo,
+		feeRepo:    feeRepo,
+	}
+}
+
+// Execute adds a schedule version for a partner, or for the default schedule when no user ID is given.
+// Versions cannot take effect in the past, so fees already charged keep the rules that priced them.
+func (uc *FeeScheduleCreateUseCase) Execute(ctx context.Context, req *request.CreateFeeScheduleRequest) (*response.FeeScheduleResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	now := time.Now()
+	effectiveFrom := now
+	if req.EffectiveFrom != nil {
+		if req.EffectiveFrom.Before(now.Add(-feeScheduleClockSkew)) {
+			return nil, apperrors.ErrInvalidEffectiveDate
+		}
+		effectiveFrom = *req.EffectiveFrom
+	}
+
+	var clientID *int64
+	if req.UserID != "" {
+		partner, err := findPartner(ctx, uc.clientRepo, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		clientID = &partner.ID
+	}
+
+	rules := make([]*entity.FeeRule, 0, len(req.Rules))
+	for _, rule := range req.Rules {
+		rules = append(rules, &entity.FeeRule{
+			Operation:  entity.FeeOperation(rule.Operation),
+			WalletType: valueobject.WalletType(rule.WalletType),
+			MinAmount:  rule.MinAmount,
+			MaxAmount:  rule.MaxAmount,
+			FixedFee:   rule.FixedFee,
+			PercentBps: rule.PercentBps,
+			MinFee:     rule.MinFee,
+			MaxFee:     rule.MaxFee,
+		})
+	}
+
+	schedule, err := entity.NewFeeSchedule(clientID, effectiveFrom, req.ClientID, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.db.Transaction(func(tx *gorm.DB) error {
+		return uc.feeRepo.Create(database.InjectTx(ctx, tx), schedule)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info.Printf("Fee schedule %d with %d rules for %s effective from %s created by admin client_id %d",
+		schedule.ID, len(schedule.Rules), feeScheduleOwner(req.UserID), effectiveFrom.Format(time.RFC3339), req.ClientID)
+
+	return &response.FeeScheduleResponse{
+		Success:  true,
+		Schedule: newFeeScheduleItem(schedule, req.UserID),
+	}, nil
+}
+
+func feeScheduleOwner(userID string) string {
+	if userID == "" {
+		return "default schedule"
+	}
+	return userID
+}
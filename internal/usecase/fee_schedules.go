@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+)
+
+// FeeSchedulesUseCase handles retrieval of fee schedule versions
+type FeeSchedulesUseCase struct {
+	clientRepo repository.ClientRepository
+	feeRepo    repository.FeeScheduleRepository
+}
+
+// NewFeeSchedulesUseCase creates a new FeeSchedulesUseCase
+func NewFeeSchedulesUseCase(clientRepo repository.ClientRepository, feeRepo repository.FeeScheduleRepository) *FeeSchedulesUseCase {
+	return &FeeSchedulesUseCase{
+		clientRepo: clientRepo,
+		feeRepo:    feeRepo,
+	}
+}
+
+// Execute returns every version of the partner's schedule, or of the default schedule, newest first
+func (uc *FeeSchedulesUseCase) Execute(ctx context.Context, req *request.ListFeeSchedulesRequest) (*response.FeeSchedulesResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	var clientID *int64
+	if req.UserID != "" {
+		partner, err := findPartner(ctx, uc.clientRepo, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		clientID = &partner.ID
+	}
+
+	schedules, err := uc.feeRepo.FindByClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.FeeSchedulesResponse{
+		UserID:    req.UserID,
+		Schedules: make([]response.FeeScheduleItem, 0, len(schedules)),
+	}
+	for _, schedule := range schedules {
+		resp.Schedules = append(resp.Schedules, newFeeScheduleItem(schedule, req.UserID))
+	}
+
+	return resp, nil
+}
+
+func newFeeScheduleItem(schedule *entity.FeeSchedule, userID string) response.FeeScheduleItem {
+	item := response.FeeScheduleItem{
+		ID:            schedule.ID,
+		UserID:        userID,
+		EffectiveFrom: schedule.EffectiveFrom,
+		Rules:         make([]response.FeeRuleItem, 0, len(schedule.Rules)),
+		CreatedAt:     schedule.CreatedAt,
+	}
+	for _, rule := range schedule.Rules {
+		item.Rules = append(item.Rules, response.FeeRuleItem{
+			ID:         rule.ID,
+			Operation:  string(rule.Operation),
+			WalletType: string(rule.WalletType),
+			MinAmount:  rule.MinAmount,
+			MaxAmount:  rule.MaxAmount,
+			FixedFee:   rule.FixedFee,
+			PercentBps: rule.PercentBps,
+			MinFee:     rule.MinFee,
+			MaxFee:     rule.MaxFee,
+		})
+	}
+
+	return item
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+)
+
+const defaultFloatMovementsPageSize = 20
+
+// FloatStatementUseCase handles retrieval of a partner's float balance and movements
+type FloatStatementUseCase struct {
+	ledgerRepo repository.LedgerRepository
+	ledger     *LedgerUseCase
+}
+
+// NewFloatStatementUseCase creates a new FloatStatementUseCase
+func NewFloatStatementUseCase(ledgerRepo repository.LedgerRepository, ledger *LedgerUseCase) *FloatStatementUseCase {
+	return &FloatStatementUseCase{
+		ledgerRepo: ledgerRepo,
+		ledger:     ledger,
+	}
+}
+
+// Execute returns the float balance of the authenticated client and one page of its movements, newest first
+func (uc *FloatStatementUseCase) Execute(ctx context.Context, req *request.FloatStatementRequest) (*response.FloatStatementResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	filter := repository.LedgerMovementFilter{Limit: req.Limit}
+	if filter.Limit == 0 {
+		filter.Limit = defaultFloatMovementsPageSize
+	}
+
+	// Movements are paged with the same opaque cursor as transaction history
+	if req.Cursor != "" {
+		createdAt, id, err := decodeTransactionCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.AfterCreatedAt = &createdAt
+		filter.AfterID = id
+	}
+
+	account, err := uc.ledger.PartnerFloatAccount(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	filter.AccountID = account.ID
+
+	// Fetch one extra row to learn whether another page follows
+	pageSize := filter.Limit
+	filter.Limit++
+	movements, err := uc.ledgerRepo.FindMovements(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.FloatStatementResponse{
+		Balance:   account.Balance,
+		Currency:  valueobject.CurrencyTJS,
+		Movements: make([]response.FloatMovementItem, 0, pageSize),
+	}
+
+	if len(movements) > pageSize {
+		movements = movements[:pageSize]
+		last := movements[pageSize-1]
+		resp.HasMore = true
+		resp.NextCursor = encodeTransactionCursor(last.CreatedAt, last.PostingID)
+	}
+
+	for _, movement := range movements {
+		resp.Movements = append(resp.Movements, response.FloatMovementItem{
+			ID:        movement.PostingID,
+			Kind:      string(movement.Kind),
+			Direction: string(movement.Direction),
+			Amount:    movement.Amount,
+			Reference: movement.Reference,
+			CreatedAt: movement.CreatedAt,
+		})
+	}
+
+	return resp, nil
+}
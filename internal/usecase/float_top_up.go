@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// FloatTopUpUseCase handles admin top-ups of partner floats
+type FloatTopUpUseCase struct {
+	db         *gorm.DB
+	clientRepo repository.ClientRepository
+	ledgerRepo repository.LedgerRepository
+	ledger     *LedgerUseCase
+}
+
+// NewFloatTopUpUseCase creates a new FloatTopUpUseCase
+func NewFloatTopUpUseCase(
+	db *gorm.DB,
+	clientRepo repository.ClientRepository,
+	ledgerRepo repository.LedgerRepository,
+	ledger *LedgerUseCase,
+) *FloatTopUpUseCase {
+	return &FloatTopUpUseCase{
+		db:         db,
+		clientRepo: clientRepo,
+		ledgerRepo: ledgerRepo,
+		ledger:     ledger,
+	}
+}
+
+// Execute credits the partner's float from the settlement account.
+// A bank reference is applied once, so a retried top-up cannot fund the float twice.
+func (uc *FloatTopUpUseCase) Execute(ctx context.Context, req *request.TopUpFloatRequest) (*response.TopUpFloatResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	amount, err := valueobject.NewMoney(req.Amount)
+	if err != nil {
+		return nil, apperrors.ErrInvalidAmount
+	}
+
+	partner, err := findPartner(ctx, uc.clientRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *response.TopUpFloatResponse
+	err = uc.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		// The float lock serialises top-ups of the partner. The same reference applied to two partners
+		// at once passes both checks, and the unique index on float top-up references rejects the second
+		floatAccount, err := uc.ledger.LockPartnerFloat(txCtx, partner.ID)
+		if err != nil {
+			return err
+		}
+
+		applied, err := uc.ledgerRepo.ExistsEntry(txCtx, entity.JournalEntryKindFloatTopUp, req.Reference)
+		if err != nil {
+			return err
+		}
+		if applied {
+			return apperrors.ErrTopUpAlreadyApplied
+		}
+
+		settlementAccount, err := uc.ledger.SystemAccount(txCtx, entity.LedgerAccountCodeSettlement, entity.LedgerAccountTypeSettlement)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.ledger.Move(txCtx, entity.JournalEntryKindFloatTopUp, req.Reference, settlementAccount, floatAccount, amount); err != nil {
+			if errors.Is(err, apperrors.ErrAlreadyExists) {
+				return apperrors.ErrTopUpAlreadyApplied
+			}
+			return err
+		}
+
+		logger.Info.Printf("Float of %s topped up by %d dirams by admin client_id %d (reference %s), new balance: %d dirams",
+			partner.UserID, amount.Dirams(), req.ClientID, req.Reference, floatAccount.Balance+amount.Amount())
+
+		resp = &response.TopUpFloatResponse{
+			Success:    true,
+			UserID:     partner.UserID,
+			Amount:     amount.Dirams(),
+			NewBalance: floatAccount.Balance + amount.Amount(),
+			Currency:   valueobject.CurrencyTJS,
+			Reference:  req.Reference,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
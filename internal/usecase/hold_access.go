@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+)
+
+// lockHold locks the hold's wallet and then the hold itself, in that order, so it cannot
+// deadlock with operations that lock only the wallet. Holds of other API clients are
+// hidden behind HOLD_NOT_FOUND.
+func lockHold(ctx context.Context, walletRepo repository.WalletRepository, holdRepo repository.HoldRepository, holdID, clientID int64) (*entity.Hold, *entity.Wallet, error) {
+	hold, err := holdRepo.FindByID(ctx, holdID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hold.ClientID != clientID {
+		logger.Warning.Printf("[usecase.lockHold]: Client %d attempted to access hold %d it did not authorize", clientID, holdID)
+		return nil, nil, apperrors.ErrHoldNotFound
+	}
+
+	wallet, err := walletRepo.FindByIDForUpdate(ctx, hold.WalletID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Re-read under the lock: the hold may have been settled in the meantime
+	hold, err = holdRepo.FindByIDForUpdate(ctx, holdID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hold, wallet, nil
+}
+
+func newHoldResponse(hold *entity.Hold, wallet *entity.Wallet) *response.HoldResponse {
+	return &response.HoldResponse{
+		Success:          true,
+		HoldID:           hold.ID,
+		AccountID:        wallet.AccountID.Value(),
+		Status:           string(hold.Status),
+		Amount:           hold.Amount.Dirams(),
+		CapturedAmount:   hold.CapturedAmount.Dirams(),
+		Balance:          wallet.Balance.Dirams(),
+		AvailableBalance: wallet.AvailableBalance().Dirams(),
+		Currency:         valueobject.CurrencyTJS,
+		ExpiresAt:        hold.ExpiresAt,
+	}
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// HoldAuthorizeUseCase reserves money on a wallet for a later capture
+type HoldAuthorizeUseCase struct {
+	db          *gorm.DB
+	walletRepo  repository.WalletRepository
+	holdRepo    repository.HoldRepository
+	idempotency *IdempotencyUseCase
+	ttl         time.Duration // how long the money stays reserved
+}
+
+// NewHoldAuthorizeUseCase creates a new HoldAuthorizeUseCase
+func NewHoldAuthorizeUseCase(
+	db *gorm.DB,
+	walletRepo repository.WalletRepository,
+	holdRepo repository.HoldRepository,
+	idempotency *IdempotencyUseCase,
+	ttl time.Duration,
+) *HoldAuthorizeUseCase {
+	return &HoldAuthorizeUseCase{
+		db:          db,
+		walletRepo:  walletRepo,
+		holdRepo:    holdRepo,
+		idempotency: idempotency,
+		ttl:         ttl,
+	}
+}
+
+// Execute reserves the amount from the available balance until it is captured, voided or expires
+func (uc *HoldAuthorizeUseCase) Execute(ctx context.Context, req *request.AuthorizeHoldRequest) (*response.HoldResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	accountID, err := valueobject.NewAccountID(req.AccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	amount, err := valueobject.NewMoney(req.Amount)
+	if err != nil {
+		return nil, apperrors.ErrInvalidAmount
+	}
+
+	// Return the stored response if this is a retry of a completed request
+	if req.IdempotencyKey != "" {
+		var replayed response.HoldResponse
+		found, err := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationHoldAuthorize, req, &replayed)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return &replayed, nil
+		}
+	}
+
+	var resp *response.HoldResponse
+	err = uc.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		// Lock the wallet row so concurrent operations cannot spend the same money
+		wallet, err := uc.walletRepo.FindOwnedByAccountIDForUpdate(txCtx, req.ClientID, accountID)
+		if err != nil {
+			return err
+		}
+
+		if err := wallet.Hold(amount); err != nil {
+			return err
+		}
+
+		if err := uc.walletRepo.Update(txCtx, wallet); err != nil {
+			return err
+		}
+
+		hold := entity.NewHold(wallet.ID, req.ClientID, amount, uuid.New().String(), uc.ttl)
+		if err := uc.holdRepo.Create(txCtx, hold); err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Hold %d authorized: %d dirams on wallet %s until %s (available balance: %d dirams)",
+			hold.ID, amount.Dirams(), accountID.Value(), hold.ExpiresAt.Format(time.RFC3339), wallet.AvailableBalance().Dirams())
+
+		resp = newHoldResponse(hold, wallet)
+
+		// Store the response in the same DB transaction as the hold
+		if req.IdempotencyKey != "" {
+			if err := uc.idempotency.Save(txCtx, req.ClientID, req.IdempotencyKey, OperationHoldAuthorize, req, resp); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		// A concurrent retry with the same key committed first; replay its response
+		if req.IdempotencyKey != "" && errors.Is(err, apperrors.ErrAlreadyExists) {
+			var replayed response.HoldResponse
+			found, replayErr := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationHoldAuthorize, req, &replayed)
+			if replayErr != nil {
+				return nil, replayErr
+			}
+			if found {
+				return &replayed, nil
+			}
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// HoldCaptureUseCase takes all or part of a held amount from the wallet
+type HoldCaptureUseCase struct {
+	db              *gorm.DB
+	walletRepo      repository.WalletRepository
+	transactionRepo repository.TransactionRepository
+	holdRepo        repository.HoldRepository
+	ledger          *LedgerUseCase
+	idempotency     *IdempotencyUseCase
+}
+
+// NewHoldCaptureUseCase creates a new HoldCaptureUseCase
+func NewHoldCaptureUseCase(
+	db *gorm.DB,
+	walletRepo repository.WalletRepository,
+	transactionRepo repository.TransactionRepository,
+	holdRepo repository.HoldRepository,
+	ledger *LedgerUseCase,
+	idempotency *IdempotencyUseCase,
+) *HoldCaptureUseCase {
+	return &HoldCaptureUseCase{
+		db:              db,
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+		holdRepo:        holdRepo,
+		ledger:          ledger,
+		idempotency:     idempotency,
+	}
+}
+
+// Execute captures the hold and releases whatever was not captured.
+// A hold is captured at most once; a retry with the same idempotency key gets the first response back.
+func (uc *HoldCaptureUseCase) Execute(ctx context.Context, req *request.CaptureHoldRequest) (*response.HoldResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	// Return the stored response if this is a retry of a completed request
+	if req.IdempotencyKey != "" {
+		var replayed response.HoldResponse
+		found, err := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationHoldCapture, req, &replayed)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return &replayed, nil
+		}
+	}
+
+	var resp *response.HoldResponse
+	err := uc.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		hold, wallet, err := lockHold(txCtx, uc.walletRepo, uc.holdRepo, req.HoldID, req.ClientID)
+		if err != nil {
+			return err
+		}
+
+		amount := hold.Amount
+		if req.Amount != 0 {
+			amount, err = valueobject.NewMoney(req.Amount)
+			if err != nil {
+				return apperrors.ErrInvalidAmount
+			}
+		}
+
+		if err := hold.Capture(amount, time.Now()); err != nil {
+			return err
+		}
+
+		// Open the ledger accounts before the balance changes
+		walletAccount, err := uc.ledger.WalletAccount(txCtx, wallet)
+		if err != nil {
+			return err
+		}
+		floatAccount, err := uc.ledger.PartnerFloatAccount(txCtx, req.ClientID)
+		if err != nil {
+			return err
+		}
+
+		if err := wallet.CaptureHold(hold.Amount, amount); err != nil {
+			return err
+		}
+
+		if err := uc.walletRepo.Update(txCtx, wallet); err != nil {
+			return err
+		}
+		if err := uc.holdRepo.Update(txCtx, hold); err != nil {
+			return err
+		}
+
+		// The capture transaction shares the hold's reference
+		transaction := entity.NewTransaction(wallet.ID, req.ClientID, entity.TransactionTypeHoldCapture, amount, wallet.Balance)
+		transaction.Reference = hold.Reference
+		if err := uc.transactionRepo.Create(txCtx, transaction); err != nil {
+			return err
+		}
+
+		// Captured money leaves the wallet to the partner's float, like a withdrawal
+		if err := uc.ledger.Move(txCtx, entity.JournalEntryKindHoldCapture, transaction.Reference, walletAccount, floatAccount, amount); err != nil {
+			return err
+		}
+		if err := uc.ledger.VerifyWallet(txCtx, wallet, walletAccount.ID); err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Hold %d captured: %d of %d dirams from wallet %s. New balance: %d dirams, Transaction ID: %d",
+			hold.ID, amount.Dirams(), hold.Amount.Dirams(), wallet.AccountID.Value(), wallet.Balance.Dirams(), transaction.ID)
+
+		resp = newHoldResponse(hold, wallet)
+		resp.TransactionID = transaction.ID
+
+		// Store the response in the same DB transaction as the capture
+		if req.IdempotencyKey != "" {
+			if err := uc.idempotency.Save(txCtx, req.ClientID, req.IdempotencyKey, OperationHoldCapture, req, resp); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		// A concurrent retry with the same key committed first; replay its response
+		if req.IdempotencyKey != "" && errors.Is(err, apperrors.ErrAlreadyExists) {
+			var replayed response.HoldResponse
+			found, replayErr := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationHoldCapture, req, &replayed)
+			if replayErr != nil {
+				return nil, replayErr
+			}
+			if found {
+				return &replayed, nil
+			}
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// holdSweepBatchSize bounds how many expired holds one sweep releases
+const holdSweepBatchSize = 100
+
+// HoldSweeper periodically releases holds whose TTL has passed
+type HoldSweeper struct {
+	db         *gorm.DB
+	walletRepo repository.WalletRepository
+	holdRepo   repository.HoldRepository
+	interval   time.Duration
+}
+
+// NewHoldSweeper creates a new HoldSweeper
+func NewHoldSweeper(db *gorm.DB, walletRepo repository.WalletRepository, holdRepo repository.HoldRepository, interval time.Duration) *HoldSweeper {
+	return &HoldSweeper{
+		db:         db,
+		walletRepo: walletRepo,
+		holdRepo:   holdRepo,
+		interval:   interval,
+	}
+}
+
+// Run sweeps every interval until ctx is cancelled
+func (s *HoldSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			released, err := s.Sweep(ctx)
+			if err != nil {
+				logger.Error.Printf("[usecase.HoldSweeper]: Sweep released %d holds with errors: %v", released, err)
+			} else if released > 0 {
+				logger.Info.Printf("[usecase.HoldSweeper]: Released %d expired holds", released)
+			}
+		}
+	}
+}
+
+// Sweep releases expired holds batch by batch and returns how many were released.
+// Each hold is released in its own transaction. It is safe to run on several instances at once:
+// a hold settled in the meantime is skipped under the lock.
+// A hold that fails is logged and left out of the rest of the sweep so it cannot hold back
+// the others; the returned error only summarizes such failures.
+func (s *HoldSweeper) Sweep(ctx context.Context) (int, error) {
+	released := 0
+	var failed []int64
+	for {
+		holds, err := s.holdRepo.FindExpired(ctx, time.Now(), failed, holdSweepBatchSize)
+		if err != nil {
+			return released, err
+		}
+
+		expired, failures := 0, 0
+		for _, hold := range holds {
+			ok, err := s.expire(ctx, hold)
+			if err != nil {
+				logger.Error.Printf("[usecase.HoldSweeper]: Failed to release expired hold %d: %v", hold.ID, err)
+				failed = append(failed, hold.ID)
+				failures++
+				continue
+			}
+			if ok {
+				expired++
+			}
+		}
+		released += expired
+
+		// Stop when the batch was the last one, or when it made no progress to avoid spinning
+		if len(holds) < holdSweepBatchSize || expired+failures == 0 {
+			break
+		}
+	}
+
+	if len(failed) > 0 {
+		return released, fmt.Errorf("[usecase.HoldSweeper.Sweep]: %d expired holds could not be released", len(failed))
+	}
+	return released, nil
+}
+
+func (s *HoldSweeper) expire(ctx context.Context, candidate *entity.Hold) (bool, error) {
+	expired := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		// Same lock order as capture and void: wallet first, then the hold
+		wallet, err := s.walletRepo.FindByIDForUpdate(txCtx, candidate.WalletID)
+		if err != nil {
+			return err
+		}
+		hold, err := s.holdRepo.FindByIDForUpdate(txCtx, candidate.ID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if !hold.IsExpired(now) {
+			return nil
+		}
+
+		if err := hold.Expire(now); err != nil {
+			return err
+		}
+		if err := wallet.ReleaseHold(hold.Amount); err != nil {
+			return err
+		}
+
+		if err := s.walletRepo.Update(txCtx, wallet); err != nil {
+			return err
+		}
+		if err := s.holdRepo.Update(txCtx, hold); err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Hold %d expired: %d dirams released on wallet %s", hold.ID, hold.Amount.Dirams(), wallet.AccountID.Value())
+		expired = true
+		return nil
+	})
+
+	return expired, err
+}
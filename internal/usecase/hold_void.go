@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// HoldVoidUseCase releases a hold without taking any money
+type HoldVoidUseCase struct {
+	db         *gorm.DB
+	walletRepo repository.WalletRepository
+	holdRepo   repository.HoldRepository
+}
+
+// NewHoldVoidUseCase creates a new HoldVoidUseCase
+func NewHoldVoidUseCase(db *gorm.DB, walletRepo repository.WalletRepository, holdRepo repository.HoldRepository) *HoldVoidUseCase {
+	return &HoldVoidUseCase{
+		db:         db,
+		walletRepo: walletRepo,
+		holdRepo:   holdRepo,
+	}
+}
+
+// Execute voids an active hold. Voiding works on frozen wallets too, since it only gives money back.
+func (uc *HoldVoidUseCase) Execute(ctx context.Context, req *request.VoidHoldRequest) (*response.HoldResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	var resp *response.HoldResponse
+	err := uc.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		hold, wallet, err := lockHold(txCtx, uc.walletRepo, uc.holdRepo, req.HoldID, req.ClientID)
+		if err != nil {
+			return err
+		}
+
+		if err := hold.Void(time.Now()); err != nil {
+			return err
+		}
+		if err := wallet.ReleaseHold(hold.Amount); err != nil {
+			return err
+		}
+
+		if err := uc.walletRepo.Update(txCtx, wallet); err != nil {
+			return err
+		}
+		if err := uc.holdRepo.Update(txCtx, hold); err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Hold %d voided: %d dirams released on wallet %s (available balance: %d dirams)",
+			hold.ID, hold.Amount.Dirams(), wallet.AccountID.Value(), wallet.AvailableBalance().Dirams())
+
+		resp = newHoldResponse(hold, wallet)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/infrastructure/cache"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/pkg/crypto"
+	apperrors "e-wallet/pkg/errors"
+	"encoding/json"
+	"errors"
+)
+
+// Operations guarded by idempotency keys
+const (
+	OperationDeposit  = "deposit"
+	OperationWithdraw = "withdraw"
+	OperationTransfer = "transfer"
+	OperationReverse  = "reverse"
+
+	OperationHoldAuthorize = "hold_authorize"
+	OperationHoldCapture   = "hold_capture"
+)
+
+// IdempotencyUseCase stores and replays responses of mutating requests.
+// Postgres is the source of truth; Redis, when available, is a read-through fast path.
+type IdempotencyUseCase struct {
+	idempotencyRepo repository.IdempotencyRepository
+	cacheRepo       repository.CacheRepository
+}
+
+func NewIdempotencyUseCase(
+	idempotencyRepo repository.IdempotencyRepository,
+	cacheRepo repository.CacheRepository,
+) *IdempotencyUseCase {
+	return &IdempotencyUseCase{
+		idempotencyRepo: idempotencyRepo,
+		cacheRepo:       cacheRepo,
+	}
+}
+
+// Replay decodes the stored response for the key into out and reports whether one was found.
+// A key reused with a different request fails with ErrIdempotencyKeyReused.
+func (uc *IdempotencyUseCase) Replay(ctx context.Context, clientID int64, key, operation string, req, out interface{}) (bool, error) {
+	requestHash, err := hashRequest(operation, req)
+	if err != nil {
+		return false, err
+	}
+
+	record, err := uc.find(ctx, clientID, key)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if !record.Matches(operation, requestHash) {
+		logger.Warning.Printf("[IdempotencyUseCase.Replay]: Key '%s' reused with a different request by client_id %d", key, clientID)
+		return false, apperrors.ErrIdempotencyKeyReused
+	}
+
+	if err := json.Unmarshal([]byte(record.Response), out); err != nil {
+		logger.Error.Printf("[IdempotencyUseCase.Replay]: Failed to decode stored response for key '%s': %v", key, err)
+		return false, apperrors.ErrInternalServerError
+	}
+
+	logger.Info.Printf("[IdempotencyUseCase.Replay]: Replaying stored response for key '%s' (client_id %d)", key, clientID)
+	return true, nil
+}
+
+// Save stores the response for the key. Call it inside the DB transaction of the operation
+// so the record is committed or rolled back together with it.
+func (uc *IdempotencyUseCase) Save(ctx context.Context, clientID int64, key, operation string, req, resp interface{}) error {
+	requestHash, err := hashRequest(operation, req)
+	if err != nil {
+		return err
+	}
+
+	respJSON, err := json.Marshal(resp)
+	if err != nil {
+		logger.Error.Printf("[IdempotencyUseCase.Save]: Failed to encode response for key '%s': %v", key, err)
+		return apperrors.ErrInternalServerError
+	}
+
+	record := entity.NewIdempotencyRecord(clientID, key, operation, requestHash, string(respJSON))
+	return uc.idempotencyRepo.Create(ctx, record)
+}
+
+func (uc *IdempotencyUseCase) find(ctx context.Context, clientID int64, key string) (*entity.IdempotencyRecord, error) {
+	cacheKey := cache.BuildIdempotencyKey(clientID, key)
+
+	if uc.cacheRepo != nil {
+		cachedData, err := uc.cacheRepo.Get(ctx, cacheKey)
+		if err == nil && cachedData != "" {
+			var record entity.IdempotencyRecord
+			if err := json.Unmarshal([]byte(cachedData), &record); err == nil {
+				return &record, nil
+			}
+		}
+	}
+
+	record, err := uc.idempotencyRepo.FindByKey(ctx, clientID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.cacheRepo != nil {
+		if recordJSON, err := json.Marshal(record); err == nil {
+			if err := uc.cacheRepo.Set(ctx, cacheKey, string(recordJSON), cache.TTLIdempotency); err != nil {
+				logger.Error.Printf("[IdempotencyUseCase.find]: Failed to cache key '%s' for client_id %d: %v", key, clientID, err)
+			}
+		}
+	}
+
+	return record, nil
+}
+
+// hashRequest fingerprints the operation and the JSON-encoded request body
+func hashRequest(operation string, req interface{}) (string, error) {
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		logger.Error.Printf("[usecase.hashRequest]: Failed to encode request: %v", err)
+		return "", apperrors.ErrInternalServerError
+	}
+
+	return crypto.ComputeSHA256(operation + ":" + string(reqJSON)), nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+)
+
+// IdentificationHistoryUseCase handles retrieval of the identification audit trail
+type IdentificationHistoryUseCase struct {
+	clientRepo         repository.ClientRepository
+	walletRepo         repository.WalletRepository
+	identificationRepo repository.IdentificationRepository
+}
+
+// NewIdentificationHistoryUseCase creates a new IdentificationHistoryUseCase
+func NewIdentificationHistoryUseCase(
+	clientRepo repository.ClientRepository,
+	walletRepo repository.WalletRepository,
+	identificationRepo repository.IdentificationRepository,
+) *IdentificationHistoryUseCase {
+	return &IdentificationHistoryUseCase{
+		clientRepo:         clientRepo,
+		walletRepo:         walletRepo,
+		identificationRepo: identificationRepo,
+	}
+}
+
+// Execute returns every identification status change of the wallet, oldest first
+func (uc *IdentificationHistoryUseCase) Execute(ctx context.Context, req *request.IdentificationHistoryRequest) (*response.IdentificationHistoryResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	accountID, err := valueobject.NewAccountID(req.AccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	wallet, err := findAdminWallet(ctx, uc.clientRepo, uc.walletRepo, req.UserID, accountID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := uc.identificationRepo.FindEventsByWalletID(ctx, wallet.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.IdentificationHistoryResponse{
+		AccountID:            accountID.Value(),
+		WalletType:           wallet.Type.String(),
+		IdentificationStatus: wallet.IdentificationStatus.String(),
+		Events:               make([]response.IdentificationEventItem, 0, len(events)),
+	}
+
+	for _, event := range events {
+		resp.Events = append(resp.Events, response.IdentificationEventItem{
+			SubmissionID:  event.SubmissionID,
+			FromStatus:    event.FromStatus.String(),
+			ToStatus:      event.ToStatus.String(),
+			ActorClientID: event.ActorClientID,
+			Reason:        event.Reason,
+			CreatedAt:     event.CreatedAt,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// IdentificationReviewUseCase handles admin approval and rejection of pending identifications
+type IdentificationReviewUseCase struct {
+	db                 *gorm.DB
+	clientRepo         repository.ClientRepository
+	walletRepo         repository.WalletRepository
+	identificationRepo repository.IdentificationRepository
+}
+
+// NewIdentificationReviewUseCase creates a new IdentificationReviewUseCase
+func NewIdentificationReviewUseCase(
+	db *gorm.DB,
+	clientRepo repository.ClientRepository,
+	walletRepo repository.WalletRepository,
+	identificationRepo repository.IdentificationRepository,
+) *IdentificationReviewUseCase {
+	return &IdentificationReviewUseCase{
+		db:                 db,
+		clientRepo:         clientRepo,
+		walletRepo:         walletRepo,
+		identificationRepo: identificationRepo,
+	}
+}
+
+// Execute approves or rejects the wallet's pending identification.
+// Approval promotes the wallet to the requested tier, identified by default.
+func (uc *IdentificationReviewUseCase) Execute(ctx context.Context, req *request.ReviewIdentificationRequest) (*response.ReviewIdentificationResponse, error) {
+	// Validate request
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if !req.Approve && reason == "" {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	accountID, err := valueobject.NewAccountID(req.AccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	tier := valueobject.WalletTypeIdentified
+	if req.WalletType != "" {
+		if !req.Approve {
+			return nil, apperrors.ErrValidationFailed
+		}
+		tier, err = valueobject.NewWalletType(req.WalletType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var resp *response.ReviewIdentificationResponse
+	err = uc.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		wallet, err := findAdminWallet(txCtx, uc.clientRepo, uc.walletRepo, req.UserID, accountID, true)
+		if err != nil {
+			return err
+		}
+
+		submission, err := uc.identificationRepo.FindPendingSubmission(txCtx, wallet.ID)
+		if err != nil {
+			return err
+		}
+
+		previousStatus := wallet.IdentificationStatus
+		if req.Approve {
+			err = wallet.ApproveIdentification(tier)
+		} else {
+			err = wallet.RejectIdentification()
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := submission.Review(req.Approve, reason); err != nil {
+			return err
+		}
+
+		if err := uc.walletRepo.Update(txCtx, wallet); err != nil {
+			return err
+		}
+		if err := uc.identificationRepo.UpdateSubmission(txCtx, submission); err != nil {
+			return err
+		}
+
+		event := entity.NewIdentificationEvent(wallet.ID, submission.ID, previousStatus, wallet.IdentificationStatus, req.ClientID, reason)
+		if err := uc.identificationRepo.CreateEvent(txCtx, event); err != nil {
+			return err
+		}
+
+		maxBalance, err := wallet.Type.MaxBalance()
+		if err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Identification of wallet %s reviewed by client_id %d: %s (%s)",
+			accountID.Value(), req.ClientID, wallet.IdentificationStatus, wallet.Type)
+
+		resp = &response.ReviewIdentificationResponse{
+			Success:              true,
+			AccountID:            accountID.Value(),
+			WalletType:           wallet.Type.String(),
+			IdentificationStatus: wallet.IdentificationStatus.String(),
+			MaxBalance:           maxBalance.Dirams(),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
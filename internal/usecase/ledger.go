@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"fmt"
+)
+
+// LedgerUseCase records money movements as balanced journal entries.
+// It is called inside the database transaction of the operation that moves the money,
+// so a wallet balance and its ledger account can never be committed out of step.
+type LedgerUseCase struct {
+	ledgerRepo repository.LedgerRepository
+}
+
+func NewLedgerUseCase(ledgerRepo repository.LedgerRepository) *LedgerUseCase {
+	return &LedgerUseCase{
+		ledgerRepo: ledgerRepo,
+	}
+}
+
+// WalletAccount returns the ledger account of a wallet, opening it on first use.
+// Wallets funded before the ledger existed get an opening balance entry against
+// the system opening balance account, so it must be called before the wallet balance changes.
+func (uc *LedgerUseCase) WalletAccount(ctx context.Context, wallet *entity.Wallet) (*entity.LedgerAccount, error) {
+	account, created, err := uc.ledgerRepo.FindOrCreateAccount(ctx, entity.NewWalletLedgerAccount(wallet.ID))
+	if err != nil {
+		return nil, err
+	}
+	if !created || wallet.Balance.Amount() == 0 {
+		return account, nil
+	}
+
+	openingAccount, err := uc.SystemAccount(ctx, entity.LedgerAccountCodeOpeningBalance, entity.LedgerAccountTypeOpeningBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	reference := fmt.Sprintf("opening:%d", wallet.ID)
+	if err := uc.Move(ctx, entity.JournalEntryKindOpeningBalance, reference, openingAccount, account, wallet.Balance); err != nil {
+		return nil, err
+	}
+
+	logger.Info.Printf("[usecase.WalletAccount]: Opened ledger account for wallet_id %d with balance %d dirams",
+		wallet.ID, wallet.Balance.Dirams())
+
+	return account, nil
+}
+
+// PartnerFloatAccount returns the float account of an API client, opening it on first use
+func (uc *LedgerUseCase) PartnerFloatAccount(ctx context.Context, clientID int64) (*entity.LedgerAccount, error) {
+	account, _, err := uc.ledgerRepo.FindOrCreateAccount(ctx, entity.NewPartnerFloatLedgerAccount(clientID))
+	return account, err
+}
+
+// LockPartnerFloat returns the float account of an API client, locked until the surrounding transaction ends
+func (uc *LedgerUseCase) LockPartnerFloat(ctx context.Context, clientID int64) (*entity.LedgerAccount, error) {
+	account, err := uc.PartnerFloatAccount(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.ledgerRepo.FindAccountByIDForUpdate(ctx, account.ID)
+}
+
+// DrawPartnerFloat locks the float account of an API client and checks that it can fund amount.
+// The lock keeps concurrent operations of the partner from spending the same float twice.
+func (uc *LedgerUseCase) DrawPartnerFloat(ctx context.Context, clientID int64, amount valueobject.Money) (*entity.LedgerAccount, error) {
+	account, err := uc.LockPartnerFloat(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Balance < amount.Amount() {
+		logger.Warning.Printf("[usecase.DrawPartnerFloat]: Float of client_id %d holds %d dirams, %d dirams requested",
+			clientID, account.Balance, amount.Dirams())
+		return nil, apperrors.ErrInsufficientFloat
+	}
+
+	return account, nil
+}
+
+// SystemAccount returns a system account, opening it on first use
+func (uc *LedgerUseCase) SystemAccount(ctx context.Context, code string, accountType entity.LedgerAccountType) (*entity.LedgerAccount, error) {
+	account, _, err := uc.ledgerRepo.FindOrCreateAccount(ctx, entity.NewSystemLedgerAccount(code, accountType))
+	return account, err
+}
+
+// Move posts a two-legged entry moving amount from the debit account to the credit account
+func (uc *LedgerUseCase) Move(
+	ctx context.Context,
+	kind entity.JournalEntryKind,
+	reference string,
+	debit, credit *entity.LedgerAccount,
+	amount valueobject.Money,
+) error {
+	entry := entity.NewJournalEntry(kind, reference)
+	entry.Debit(debit.ID, amount)
+	entry.Credit(credit.ID, amount)
+
+	if err := entry.Validate(); err != nil {
+		logger.Error.Printf("[usecase.Move]: Rejected unbalanced %s entry %s", kind, reference)
+		return err
+	}
+
+	return uc.ledgerRepo.CreateEntry(ctx, entry)
+}
+
+// VerifyWallet checks that the stored wallet balance equals its ledger account balance.
+// A mismatch fails the surrounding transaction instead of committing drift.
+func (uc *LedgerUseCase) VerifyWallet(ctx context.Context, wallet *entity.Wallet, accountID int64) error {
+	account, err := uc.ledgerRepo.FindAccountByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if account.Balance != wallet.Balance.Amount() {
+		logger.Error.Printf("[usecase.VerifyWallet]: Wallet_id %d balance %d dirams does not match ledger balance %d dirams",
+			wallet.ID, wallet.Balance.Dirams(), account.Balance)
+		return apperrors.ErrLedgerMismatch
+	}
+
+	return nil
+}
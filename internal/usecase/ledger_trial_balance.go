@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/logger"
+)
+
+// LedgerTrialBalanceUseCase proves that the ledger neither created nor destroyed money
+type LedgerTrialBalanceUseCase struct {
+	ledgerRepo repository.LedgerRepository
+}
+
+// NewLedgerTrialBalanceUseCase creates a new LedgerTrialBalanceUseCase
+func NewLedgerTrialBalanceUseCase(ledgerRepo repository.LedgerRepository) *LedgerTrialBalanceUseCase {
+	return &LedgerTrialBalanceUseCase{
+		ledgerRepo: ledgerRepo,
+	}
+}
+
+// Execute sums all account balances per type and checks every account against its postings
+func (uc *LedgerTrialBalanceUseCase) Execute(ctx context.Context) (*response.LedgerTrialBalanceResponse, error) {
+	balances, err := uc.ledgerRepo.GetTrialBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	drifted, err := uc.ledgerRepo.FindDriftedAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.LedgerTrialBalanceResponse{
+		Currency:        valueobject.CurrencyTJS,
+		ByType:          make([]response.LedgerTypeBalanceItem, 0, len(balances)),
+		DriftedAccounts: make([]response.LedgerAccountItem, 0, len(drifted)),
+	}
+
+	for _, balance := range balances {
+		resp.Total += balance.Balance
+		resp.ByType = append(resp.ByType, response.LedgerTypeBalanceItem{
+			Type:     string(balance.Type),
+			Accounts: balance.Accounts,
+			Balance:  balance.Balance,
+		})
+	}
+
+	for _, account := range drifted {
+		resp.DriftedAccounts = append(resp.DriftedAccounts, response.LedgerAccountItem{
+			Code:    account.Code,
+			Type:    string(account.Type),
+			Balance: account.Balance,
+		})
+	}
+
+	resp.Balanced = resp.Total == 0 && len(drifted) == 0
+	if !resp.Balanced {
+		logger.Warning.Printf("[usecase.LedgerTrialBalance]: Ledger out of balance: total %d dirams, %d drifted accounts",
+			resp.Total, len(drifted))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+)
+
+// findPartner resolves the partner an admin operation targets. Admin clients do not move
+// customer money, so they are not partners and are reported as PARTNER_NOT_FOUND too.
+func findPartner(ctx context.Context, clientRepo repository.ClientRepository, userID string) (*entity.APIClient, error) {
+	partner, err := clientRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrClientNotFound) {
+			return nil, apperrors.ErrPartnerNotFound
+		}
+		return nil, err
+	}
+	if partner.IsAdmin {
+		return nil, apperrors.ErrPartnerNotFound
+	}
+
+	return partner, nil
+}
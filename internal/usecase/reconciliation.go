@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ReconciliationUseCase compares every stored wallet balance with the balance
+// recomputed from its transactions and keeps a report of each run
+type ReconciliationUseCase struct {
+	db                 *gorm.DB
+	reconciliationRepo repository.ReconciliationRepository
+}
+
+// NewReconciliationUseCase creates a new ReconciliationUseCase
+func NewReconciliationUseCase(db *gorm.DB, reconciliationRepo repository.ReconciliationRepository) *ReconciliationUseCase {
+	return &ReconciliationUseCase{
+		db:                 db,
+		reconciliationRepo: reconciliationRepo,
+	}
+}
+
+// Execute runs a reconciliation over all wallets and stores the run with its discrepancies
+func (uc *ReconciliationUseCase) Execute(ctx context.Context, req *request.RunReconciliationRequest) (*response.ReconciliationResponse, error) {
+	run := entity.NewReconciliationRun(req.ClientID)
+
+	checks, err := uc.reconciliationRepo.ComputeWalletBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, check := range checks {
+		run.Check(check.WalletID, check.AccountID, check.StoredBalance, check.ComputedBalance)
+	}
+	run.FinishedAt = time.Now()
+
+	err = uc.db.Transaction(func(tx *gorm.DB) error {
+		return uc.reconciliationRepo.CreateRun(database.InjectTx(ctx, tx), run)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(run.Discrepancies) > 0 {
+		logger.Warning.Printf("[usecase.Reconciliation]: Run %d found %d of %d wallets out of balance",
+			run.ID, len(run.Discrepancies), run.WalletsChecked)
+	} else {
+		logger.Info.Printf("[usecase.Reconciliation]: Run %d checked %d wallets, all balanced", run.ID, run.WalletsChecked)
+	}
+
+	resp := &response.ReconciliationResponse{
+		RunID:          run.ID,
+		Balanced:       len(run.Discrepancies) == 0,
+		WalletsChecked: run.WalletsChecked,
+		TotalStored:    run.TotalStored,
+		TotalComputed:  run.TotalComputed,
+		Currency:       valueobject.CurrencyTJS,
+		Discrepancies:  make([]response.ReconciliationDiscrepancyItem, 0, len(run.Discrepancies)),
+		StartedAt:      run.StartedAt,
+		FinishedAt:     run.FinishedAt,
+	}
+
+	for _, discrepancy := range run.Discrepancies {
+		resp.Discrepancies = append(resp.Discrepancies, response.ReconciliationDiscrepancyItem{
+			AccountID:       discrepancy.AccountID,
+			StoredBalance:   discrepancy.StoredBalance,
+			ComputedBalance: discrepancy.ComputedBalance,
+			Difference:      discrepancy.Difference(),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/infrastructure/cache"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	minNonceLength = 8
+	maxNonceLength = 128
+)
+
+// ReplayGuardUseCase rejects stale or repeated requests of replay-protected clients.
+// Nonces are tracked in Redis and fall back to Postgres when Redis is unavailable.
+type ReplayGuardUseCase struct {
+	nonceRepo repository.NonceRepository
+	cacheRepo repository.CacheRepository
+	skew      time.Duration
+}
+
+func NewReplayGuardUseCase(
+	nonceRepo repository.NonceRepository,
+	cacheRepo repository.CacheRepository,
+	skew time.Duration,
+) *ReplayGuardUseCase {
+	return &ReplayGuardUseCase{
+		nonceRepo: nonceRepo,
+		cacheRepo: cacheRepo,
+		skew:      skew,
+	}
+}
+
+// ValidateTimestamp checks that the X-Timestamp header is within the allowed skew of the server clock
+func (uc *ReplayGuardUseCase) ValidateTimestamp(timestamp string) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return apperrors.ErrInvalidTimestamp
+	}
+
+	drift := time.Since(time.Unix(seconds, 0))
+	if drift > uc.skew || drift < -uc.skew {
+		return apperrors.ErrTimestampOutOfWindow
+	}
+
+	return nil
+}
+
+// ValidateNonce checks the X-Nonce header format
+func (uc *ReplayGuardUseCase) ValidateNonce(nonce string) error {
+	if len(nonce) < minNonceLength || len(nonce) > maxNonceLength {
+		return apperrors.ErrInvalidNonce
+	}
+	return nil
+}
+
+// RegisterNonce records the nonce for the client and fails with ErrNonceReused if it was seen
+// within the replay window
+func (uc *ReplayGuardUseCase) RegisterNonce(ctx context.Context, clientID int64, nonce string) error {
+	// A timestamp is accepted for skew in either direction, so a nonce must be remembered twice as long
+	retention := 2 * uc.skew
+
+	if uc.cacheRepo != nil {
+		stored, err := uc.cacheRepo.SetNX(ctx, cache.BuildNonceKey(clientID, nonce), "1", retention)
+		if err == nil {
+			if !stored {
+				return apperrors.ErrNonceReused
+			}
+			return nil
+		}
+		logger.Error.Printf("[ReplayGuardUseCase.RegisterNonce]: Redis unavailable, falling back to Postgres: %v", err)
+	}
+
+	if err := uc.nonceRepo.DeleteOlderThan(ctx, time.Now().Add(-retention)); err != nil {
+		logger.Error.Printf("[ReplayGuardUseCase.RegisterNonce]: Failed to purge expired nonces: %v", err)
+	}
+
+	if err := uc.nonceRepo.Create(ctx, clientID, nonce); err != nil {
+		if errors.Is(err, apperrors.ErrAlreadyExists) {
+			return apperrors.ErrNonceReused
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+)
+
+// settlementCSVHeader names the CSV columns; amounts are in dirams
+var settlementCSVHeader = []string{
+	"business_date",
+	"deposit_count", "deposit_amount",
+	"deposit_reversal_count", "deposit_reversal_amount",
+	"withdrawal_count", "withdrawal_amount",
+	"withdrawal_reversal_count", "withdrawal_reversal_amount",
+	"capture_count", "capture_amount",
+	"fee_count", "fee_amount",
+	"fee_refund_count", "fee_refund_amount",
+	"net_amount", "currency", "generated_at",
+}
+
+// SettlementExportUseCase renders the partner's daily settlements as a CSV file
+type SettlementExportUseCase struct {
+	settlementRepo repository.SettlementRepository
+}
+
+// NewSettlementExportUseCase creates a new SettlementExportUseCase
+func NewSettlementExportUseCase(settlementRepo repository.SettlementRepository) *SettlementExportUseCase {
+	return &SettlementExportUseCase{
+		settlementRepo: settlementRepo,
+	}
+}
+
+// Execute returns the same settlements as SettlementsUseCase, one CSV row per business day
+func (uc *SettlementExportUseCase) Execute(ctx context.Context, req *request.ListSettlementsRequest) (*response.SettlementExport, error) {
+	settlements, err := findSettlements(ctx, uc.settlementRepo, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(settlementCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, settlement := range settlements {
+		row := []string{settlement.BusinessDate.Format(dateLayout)}
+		for _, value := range []int64{
+			settlement.DepositCount, settlement.DepositAmount,
+			settlement.DepositReversalCount, settlement.DepositReversalAmount,
+			settlement.WithdrawalCount, settlement.WithdrawalAmount,
+			settlement.WithdrawalReversalCount, settlement.WithdrawalReversalAmount,
+			settlement.CaptureCount, settlement.CaptureAmount,
+			settlement.FeeCount, settlement.FeeAmount,
+			settlement.FeeRefundCount, settlement.FeeRefundAmount,
+			settlement.NetAmount,
+		} {
+			row = append(row, strconv.FormatInt(value, 10))
+		}
+		row = append(row, valueobject.CurrencyTJS, settlement.GeneratedAt.UTC().Format("2006-01-02T15:04:05Z"))
+
+		if err := writer.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return &response.SettlementExport{
+		FileName: fmt.Sprintf("settlements_%s_%s.csv", req.From, req.To),
+		Content:  buf.Bytes(),
+	}, nil
+}
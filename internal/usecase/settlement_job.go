@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/utils"
+	"time"
+)
+
+// SettlementJob generates the daily settlement of every partner once a business day has ended
+type SettlementJob struct {
+	settlementRepo repository.SettlementRepository
+	location       *time.Location
+	interval       time.Duration
+	delay          time.Duration
+	catchUpDays    int
+}
+
+// NewSettlementJob creates a new SettlementJob
+func NewSettlementJob(
+	settlementRepo repository.SettlementRepository,
+	location *time.Location,
+	interval, delay time.Duration,
+	catchUpDays int,
+) *SettlementJob {
+	return &SettlementJob{
+		settlementRepo: settlementRepo,
+		location:       location,
+		interval:       interval,
+		delay:          delay,
+		catchUpDays:    catchUpDays,
+	}
+}
+
+// Run settles the ended business days right away and then every interval until ctx is cancelled
+func (j *SettlementJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		created, err := j.SettleEndedDays(ctx)
+		if err != nil {
+			logger.Error.Printf("[usecase.SettlementJob]: Run failed after creating %d settlements: %v", created, err)
+		} else if created > 0 {
+			logger.Info.Printf("[usecase.SettlementJob]: Created %d settlements", created)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SettleEndedDays settles the last catchUpDays business days that have ended and returns
+// how many settlements were created
+func (j *SettlementJob) SettleEndedDays(ctx context.Context) (int64, error) {
+	lastEnded := time.Now().Add(-j.delay).In(j.location).AddDate(0, 0, -1)
+
+	var created int64
+	for i := j.catchUpDays - 1; i >= 0; i-- {
+		count, err := j.Settle(ctx, lastEnded.AddDate(0, 0, -i))
+		if err != nil {
+			return created, err
+		}
+		created += count
+	}
+
+	return created, nil
+}
+
+// Settle generates the settlements of the business day containing day and returns how many were created.
+// Settlements that already exist are kept, so it is safe to run again or on several instances at once.
+// The day must have ended at least delay ago, so operations committing around midnight are not missed.
+func (j *SettlementJob) Settle(ctx context.Context, day time.Time) (int64, error) {
+	day = day.In(j.location)
+	from, to := utils.DayRange(day, day)
+	if to.Add(j.delay).After(time.Now()) {
+		return 0, apperrors.ErrInvalidBusinessDate
+	}
+
+	totals, err := j.settlementRepo.AggregateDay(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	// Totals are ordered by partner, so each partner's rows are adjacent
+	settlements := make([]*entity.Settlement, 0)
+	for _, total := range totals {
+		if n := len(settlements); n == 0 || settlements[n-1].ClientID != total.ClientID {
+			settlements = append(settlements, entity.NewSettlement(total.ClientID, from))
+		}
+		settlements[len(settlements)-1].Add(entity.TransactionType(total.Type), total.Count, total.Amount)
+	}
+
+	created, err := j.settlementRepo.CreateMissing(ctx, settlements)
+	if err != nil {
+		return 0, err
+	}
+	if created > 0 {
+		logger.Info.Printf("[usecase.SettlementJob]: Settled %s for %d partners", from.Format(dateLayout), created)
+	}
+
+	return created, nil
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"time"
+)
+
+// SettlementRunUseCase lets admins settle a business day on demand, e.g. to backfill days
+// from before the settlement job was deployed
+type SettlementRunUseCase struct {
+	job            *SettlementJob
+	settlementRepo repository.SettlementRepository
+	clientRepo     repository.ClientRepository
+	location       *time.Location
+}
+
+// NewSettlementRunUseCase creates a new SettlementRunUseCase
+func NewSettlementRunUseCase(
+	job *SettlementJob,
+	settlementRepo repository.SettlementRepository,
+	clientRepo repository.ClientRepository,
+	location *time.Location,
+) *SettlementRunUseCase {
+	return &SettlementRunUseCase{
+		job:            job,
+		settlementRepo: settlementRepo,
+		clientRepo:     clientRepo,
+		location:       location,
+	}
+}
+
+// Execute settles the business day and returns the settlements of every partner for it
+func (uc *SettlementRunUseCase) Execute(ctx context.Context, req *request.RunSettlementRequest) (*response.RunSettlementResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	day, err := time.ParseInLocation(dateLayout, req.BusinessDate, uc.location)
+	if err != nil {
+		return nil, apperrors.ErrInvalidBusinessDate
+	}
+
+	created, err := uc.job.Settle(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+
+	settlements, err := uc.settlementRepo.FindByDate(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.RunSettlementResponse{
+		BusinessDate: req.BusinessDate,
+		Created:      created,
+		Currency:     valueobject.CurrencyTJS,
+		Settlements:  make([]response.SettlementItem, 0, len(settlements)),
+	}
+	for _, settlement := range settlements {
+		partner, err := uc.clientRepo.FindByID(ctx, settlement.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Settlements = append(resp.Settlements, newSettlementItem(settlement, partner.UserID))
+	}
+
+	logger.Info.Printf("[usecase.SettlementRun]: Client %d settled %s, %d settlements created",
+		req.ClientID, req.BusinessDate, created)
+
+	return resp, nil
+}
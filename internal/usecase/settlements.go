@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"time"
+)
+
+// maxSettlementDays bounds the business dates one settlements request covers
+const maxSettlementDays = 92
+
+// SettlementsUseCase handles retrieval of the partner's daily settlements
+type SettlementsUseCase struct {
+	settlementRepo repository.SettlementRepository
+}
+
+// NewSettlementsUseCase creates a new SettlementsUseCase
+func NewSettlementsUseCase(settlementRepo repository.SettlementRepository) *SettlementsUseCase {
+	return &SettlementsUseCase{
+		settlementRepo: settlementRepo,
+	}
+}
+
+// Execute returns the settlements of the authenticated partner for the requested business dates
+func (uc *SettlementsUseCase) Execute(ctx context.Context, req *request.ListSettlementsRequest) (*response.SettlementsResponse, error) {
+	settlements, err := findSettlements(ctx, uc.settlementRepo, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.SettlementsResponse{
+		From:        req.From,
+		To:          req.To,
+		Currency:    valueobject.CurrencyTJS,
+		Settlements: make([]response.SettlementItem, 0, len(settlements)),
+	}
+	for _, settlement := range settlements {
+		resp.TotalNetAmount += settlement.NetAmount
+		resp.Settlements = append(resp.Settlements, newSettlementItem(settlement, ""))
+	}
+
+	return resp, nil
+}
+
+// findSettlements validates the request and loads the partner's settlements in its range
+func findSettlements(ctx context.Context, settlementRepo repository.SettlementRepository, req *request.ListSettlementsRequest) ([]*entity.Settlement, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	// Business dates are calendar dates, so they are compared without a time zone
+	from, err := time.Parse(dateLayout, req.From)
+	if err != nil {
+		return nil, apperrors.ErrInvalidDateRange
+	}
+	to, err := time.Parse(dateLayout, req.To)
+	if err != nil {
+		return nil, apperrors.ErrInvalidDateRange
+	}
+	if to.Before(from) || to.After(from.AddDate(0, 0, maxSettlementDays-1)) {
+		return nil, apperrors.ErrInvalidDateRange
+	}
+
+	return settlementRepo.FindByClient(ctx, req.ClientID, from, to)
+}
+
+func newSettlementItem(settlement *entity.Settlement, userID string) response.SettlementItem {
+	return response.SettlementItem{
+		UserID:                   userID,
+		BusinessDate:             settlement.BusinessDate.Format(dateLayout),
+		DepositCount:             settlement.DepositCount,
+		DepositAmount:            settlement.DepositAmount,
+		DepositReversalCount:     settlement.DepositReversalCount,
+		DepositReversalAmount:    settlement.DepositReversalAmount,
+		WithdrawalCount:          settlement.WithdrawalCount,
+		WithdrawalAmount:         settlement.WithdrawalAmount,
+		WithdrawalReversalCount:  settlement.WithdrawalReversalCount,
+		WithdrawalReversalAmount: settlement.WithdrawalReversalAmount,
+		CaptureCount:             settlement.CaptureCount,
+		CaptureAmount:            settlement.CaptureAmount,
+		FeeCount:                 settlement.FeeCount,
+		FeeAmount:                settlement.FeeAmount,
+		FeeRefundCount:           settlement.FeeRefundCount,
+		FeeRefundAmount:          settlement.FeeRefundAmount,
+		NetAmount:                settlement.NetAmount,
+		GeneratedAt:              settlement.GeneratedAt,
+	}
+}
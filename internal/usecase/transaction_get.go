@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+)
+
+// TransactionGetUseCase handles the lookup of a single transaction
+type TransactionGetUseCase struct {
+	walletRepo      repository.WalletRepository
+	transactionRepo repository.TransactionRepository
+}
+
+// NewTransactionGetUseCase creates a new TransactionGetUseCase
+func NewTransactionGetUseCase(walletRepo repository.WalletRepository, transactionRepo repository.TransactionRepository) *TransactionGetUseCase {
+	return &TransactionGetUseCase{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// Execute returns a transaction the client made or that belongs to one of its wallets.
+// External IDs are looked up among the client's own transactions only.
+func (uc *TransactionGetUseCase) Execute(ctx context.Context, req *request.GetTransactionRequest) (*response.TransactionResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	transaction, wallet, err := findVisibleTransaction(ctx, uc.walletRepo, uc.transactionRepo, req.ClientID, req.TransactionID, req.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.TransactionResponse{
+		ID:                    transaction.ID,
+		AccountID:             wallet.AccountID.Value(),
+		Type:                  string(transaction.Type),
+		Amount:                transaction.Amount.Dirams(),
+		Currency:              valueobject.CurrencyTJS,
+		Reference:             transaction.Reference,
+		ExternalID:            transaction.ExternalID,
+		Description:           transaction.Description,
+		Metadata:              transaction.Metadata,
+		OriginalTransactionID: transaction.OriginalTransactionID,
+		FeeRuleID:             transaction.FeeRuleID,
+		Status:                string(transaction.Status),
+		CreatedAt:             transaction.CreatedAt,
+		UpdatedAt:             transaction.UpdatedAt,
+	}
+	if transaction.BalanceAfter != nil {
+		balance := transaction.BalanceAfter.Dirams()
+		resp.BalanceAfter = &balance
+	}
+
+	return resp, nil
+}
+
+// findVisibleTransaction finds a transaction by ID or external ID together with its wallet.
+// Transactions the client may not see are hidden behind TRANSACTION_NOT_FOUND.
+func findVisibleTransaction(
+	ctx context.Context,
+	walletRepo repository.WalletRepository,
+	transactionRepo repository.TransactionRepository,
+	clientID, transactionID int64,
+	externalID string,
+) (*entity.Transaction, *entity.Wallet, error) {
+	var transaction *entity.Transaction
+	var err error
+	if externalID != "" {
+		transaction, err = transactionRepo.FindByExternalID(ctx, clientID, externalID)
+	} else {
+		transaction, err = transactionRepo.FindByID(ctx, transactionID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wallet, err := walletRepo.FindByID(ctx, transaction.WalletID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !transaction.VisibleTo(clientID, wallet) {
+		logger.Warning.Printf("[usecase.findVisibleTransaction]: Client %d attempted to access transaction %d", clientID, transaction.ID)
+		return nil, nil, apperrors.ErrTransactionNotFound
+	}
+
+	return transaction, wallet, nil
+}
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TransactionReverseUseCase reverses deposits and withdrawals with a compensating transaction
+type TransactionReverseUseCase struct {
+	db              *gorm.DB
+	walletRepo      repository.WalletRepository
+	transactionRepo repository.TransactionRepository
+	ledger          *LedgerUseCase
+	fees            *FeeUseCase
+	idempotency     *IdempotencyUseCase
+}
+
+// NewTransactionReverseUseCase creates a new TransactionReverseUseCase
+func NewTransactionReverseUseCase(
+	db *gorm.DB,
+	walletRepo repository.WalletRepository,
+	transactionRepo repository.TransactionRepository,
+	ledger *LedgerUseCase,
+	fees *FeeUseCase,
+	idempotency *IdempotencyUseCase,
+) *TransactionReverseUseCase {
+	return &TransactionReverseUseCase{
+		db:              db,
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+		ledger:          ledger,
+		fees:            fees,
+		idempotency:     idempotency,
+	}
+}
+
+// Execute reverses all or part of a transaction made by the calling client.
+// A transaction may be reversed in several parts until their total reaches its amount.
+// The operation's fee is refunded in proportion to the part reversed.
+// Since a repeated reversal is a valid request, every reversal needs an idempotency key.
+func (uc *TransactionReverseUseCase) Execute(ctx context.Context, req *request.ReverseTransactionRequest) (*response.ReverseTransactionResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+	if req.IdempotencyKey == "" {
+		return nil, apperrors.ErrIdempotencyKeyMissing
+	}
+
+	// Return the stored response if this is a retry of a completed reversal
+	var replayed response.ReverseTransactionResponse
+	found, err := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationReverse, req, &replayed)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return &replayed, nil
+	}
+
+	var resp *response.ReverseTransactionResponse
+	err = uc.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		original, err := uc.transactionRepo.FindByID(txCtx, req.TransactionID)
+		if err != nil {
+			return err
+		}
+
+		// Lock the wallet row first, like every other balance change, then the original,
+		// whose reversed amount is read and updated under its row lock
+		wallet, err := uc.walletRepo.FindByIDForUpdate(txCtx, original.WalletID)
+		if err != nil {
+			return err
+		}
+		original, err = uc.transactionRepo.FindByIDForUpdate(txCtx, original.ID)
+		if err != nil {
+			return err
+		}
+
+		// Transactions of other clients are hidden behind TRANSACTION_NOT_FOUND
+		if !original.MadeBy(req.ClientID, wallet) || !wallet.IsOwnedBy(req.ClientID) {
+			logger.Warning.Printf("[usecase.TransactionReverse]: Client %d attempted to reverse transaction %d it did not make",
+				req.ClientID, original.ID)
+			return apperrors.ErrTransactionNotFound
+		}
+
+		reversalType, ok := original.Type.ReversalType()
+		if !ok {
+			return apperrors.ErrNotReversible
+		}
+
+		// Without an amount, whatever has not been reversed yet is reversed
+		amount := original.ReversibleAmount()
+		if req.Amount != 0 {
+			amount, err = valueobject.NewMoney(req.Amount)
+			if err != nil {
+				return apperrors.ErrInvalidAmount
+			}
+		}
+		if amount.Amount() == 0 {
+			return apperrors.ErrReversalAmount
+		}
+		if err := original.ApplyReversal(amount); err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Reversing %d of %d dirams of transaction %d on wallet %s (reversed in total: %d dirams, current balance: %d dirams)",
+			amount.Dirams(), original.Amount.Dirams(), original.ID, wallet.AccountID.Value(), original.ReversedAmount.Dirams(), wallet.Balance.Dirams())
+
+		// Open the ledger accounts before the balance changes
+		walletAccount, err := uc.ledger.WalletAccount(txCtx, wallet)
+		if err != nil {
+			return err
+		}
+
+		// Refund the fee to the partner's float first, so a withdrawal reversal can draw on it
+		reference := uuid.New().String()
+		feeRefund, err := uc.fees.Refund(txCtx, wallet, req.ClientID, reference, original)
+		if err != nil {
+			return err
+		}
+
+		// A deposit reversal returns the money to the partner's float, a withdrawal reversal takes it back
+		var floatAccount *entity.LedgerAccount
+		if reversalType == entity.TransactionTypeDepositReversal {
+			floatAccount, err = uc.ledger.PartnerFloatAccount(txCtx, req.ClientID)
+		} else {
+			floatAccount, err = uc.ledger.DrawPartnerFloat(txCtx, req.ClientID, amount)
+		}
+		if err != nil {
+			return err
+		}
+
+		debit, credit := walletAccount, floatAccount
+		if reversalType == entity.TransactionTypeDepositReversal {
+			err = wallet.Withdraw(amount)
+		} else {
+			debit, credit = floatAccount, walletAccount
+			err = wallet.Deposit(amount)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := uc.walletRepo.Update(txCtx, wallet); err != nil {
+			return err
+		}
+
+		reversal := entity.NewTransaction(wallet.ID, req.ClientID, reversalType, amount, wallet.Balance)
+		reversal.Reference = reference
+		reversal.OriginalTransactionID = &original.ID
+		if err := uc.transactionRepo.Create(txCtx, reversal); err != nil {
+			return err
+		}
+		if err := uc.transactionRepo.UpdateStatus(txCtx, original); err != nil {
+			return err
+		}
+
+		if err := uc.ledger.Move(txCtx, entity.JournalEntryKindReversal, reversal.Reference, debit, credit, amount); err != nil {
+			return err
+		}
+		if err := uc.ledger.VerifyWallet(txCtx, wallet, walletAccount.ID); err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Reversal successful. New balance: %d dirams, Transaction ID: %d",
+			wallet.Balance.Dirams(), reversal.ID)
+
+		resp = &response.ReverseTransactionResponse{
+			Success:               true,
+			TransactionID:         reversal.ID,
+			OriginalTransactionID: original.ID,
+			AccountID:             wallet.AccountID.Value(),
+			Type:                  string(reversalType),
+			Amount:                amount.Dirams(),
+			ReversedAmount:        original.ReversedAmount.Dirams(),
+			NewBalance:            wallet.Balance.Dirams(),
+			Currency:              valueobject.CurrencyTJS,
+		}
+		if feeRefund != nil {
+			resp.FeeRefund = feeRefund.Amount.Dirams()
+			resp.FeeRefundTransactionID = &feeRefund.ID
+		}
+
+		// Store the response in the same DB transaction as the reversal
+		return uc.idempotency.Save(txCtx, req.ClientID, req.IdempotencyKey, OperationReverse, req, resp)
+	})
+
+	if err != nil {
+		// A concurrent retry with the same key committed first; replay its response
+		if errors.Is(err, apperrors.ErrAlreadyExists) {
+			found, replayErr := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationReverse, req, &replayed)
+			if replayErr != nil {
+				return nil, replayErr
+			}
+			if found {
+				return &replayed, nil
+			}
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
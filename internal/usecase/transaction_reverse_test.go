@@ -0,0 +1,79 @@
+package usecase_test
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/dto/request"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+	"testing"
+)
+
+// TestReplayedReversalIsAppliedOnce sends one partial reversal many times in parallel with the same
+// idempotency key, then once more, and checks that all attempts got the same reversal and only one moved money.
+func TestReplayedReversalIsAppliedOnce(t *testing.T) {
+	env := newConcurrencyEnv(t)
+	ctx := context.Background()
+	wallet := env.createWallet(t, "reverse", tierOpen)
+
+	deposit, err := env.deposit.Execute(ctx, &request.DepositRequest{
+		AccountID: wallet.AccountID.Value(),
+		Amount:    10000,
+		ClientID:  env.clientID,
+	})
+	if err != nil {
+		t.Fatalf("deposit: %v", err)
+	}
+
+	reversal := func() *request.ReverseTransactionRequest {
+		return &request.ReverseTransactionRequest{
+			TransactionID:  deposit.TransactionID,
+			Amount:         4000,
+			ClientID:       env.clientID,
+			IdempotencyKey: "reverse-" + env.suffix,
+		}
+	}
+
+	const attempts = 20
+	reversalIDs := make([]int64, attempts)
+	operations := make([]func() operationResult, 0, attempts)
+	for i := range attempts {
+		operations = append(operations, func() operationResult {
+			resp, err := env.reverse.Execute(ctx, reversal())
+			if err != nil {
+				return operationResult{err: err}
+			}
+			reversalIDs[i] = resp.TransactionID
+			return operationResult{amount: resp.Amount}
+		})
+	}
+	for _, result := range runParallel(operations) {
+		if result.err != nil {
+			t.Fatalf("reversal: %v", result.err)
+		}
+	}
+
+	retry, err := env.reverse.Execute(ctx, reversal())
+	if err != nil {
+		t.Fatalf("retry after the reversal committed: %v", err)
+	}
+	for i, id := range append(reversalIDs, retry.TransactionID) {
+		if id != reversalIDs[0] {
+			t.Errorf("attempt %d got reversal %d, want the first reversal %d", i, id, reversalIDs[0])
+		}
+	}
+
+	if count := env.countTransactions(t, wallet, entity.TransactionTypeDepositReversal); count != 1 {
+		t.Errorf("%d reversals recorded, want 1", count)
+	}
+	if balance := env.reload(t, wallet).Balance.Dirams(); balance != 6000 {
+		t.Errorf("balance %d, want 6000 after a single reversal of 4000", balance)
+	}
+
+	// The same key with another amount is a different request
+	req := reversal()
+	req.Amount = 1000
+	if _, err := env.reverse.Execute(ctx, req); !errors.Is(err, apperrors.ErrIdempotencyKeyReused) {
+		t.Errorf("key reused for another amount: error = %v, want IDEMPOTENCY_KEY_REUSED", err)
+	}
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+)
+
+// TransactionStatusUseCase tells partners whether an operation went through, e.g. after a timeout
+type TransactionStatusUseCase struct {
+	walletRepo      repository.WalletRepository
+	transactionRepo repository.TransactionRepository
+}
+
+// NewTransactionStatusUseCase creates a new TransactionStatusUseCase
+func NewTransactionStatusUseCase(walletRepo repository.WalletRepository, transactionRepo repository.TransactionRepository) *TransactionStatusUseCase {
+	return &TransactionStatusUseCase{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// Execute returns the status of a transaction visible to the client and, once reversed, its reversal.
+// Operations are recorded in the same DB transaction that applies them, so TRANSACTION_NOT_FOUND
+// for an external ID means the operation did not happen and can be retried with the same external ID.
+func (uc *TransactionStatusUseCase) Execute(ctx context.Context, req *request.GetTransactionStatusRequest) (*response.TransactionStatusResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	transaction, wallet, err := findVisibleTransaction(ctx, uc.walletRepo, uc.transactionRepo, req.ClientID, req.TransactionID, req.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.TransactionStatusResponse{
+		TransactionID:  transaction.ID,
+		ExternalID:     transaction.ExternalID,
+		AccountID:      wallet.AccountID.Value(),
+		Type:           string(transaction.Type),
+		Status:         string(transaction.Status),
+		Amount:         transaction.Amount.Dirams(),
+		ReversedAmount: transaction.ReversedAmount.Dirams(),
+		Currency:       valueobject.CurrencyTJS,
+		CreatedAt:      transaction.CreatedAt,
+		UpdatedAt:      transaction.UpdatedAt,
+	}
+
+	if transaction.Status == entity.TransactionStatusReversed {
+		reversals, err := uc.transactionRepo.FindReversals(ctx, transaction.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, reversal := range reversals {
+			resp.Reversals = append(resp.Reversals, response.TransactionReversalItem{
+				TransactionID: reversal.ID,
+				Amount:        reversal.Amount.Dirams(),
+				CreatedAt:     reversal.CreatedAt,
+			})
+		}
+	}
+
+	return resp, nil
+}
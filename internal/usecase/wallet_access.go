@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+)
+
+// findAdminWallet resolves the wallet an admin operation targets. Account IDs are unique per partner only,
+// so userID names the owning partner; without it the account ID must be held by a single partner,
+// otherwise WALLET_AMBIGUOUS is returned. With lock set the wallet row is locked until the transaction ends.
+func findAdminWallet(
+	ctx context.Context,
+	clientRepo repository.ClientRepository,
+	walletRepo repository.WalletRepository,
+	userID string,
+	accountID valueobject.AccountID,
+	lock bool,
+) (*entity.Wallet, error) {
+	if userID == "" {
+		if lock {
+			return walletRepo.FindByAccountIDForUpdate(ctx, accountID)
+		}
+		return walletRepo.FindByAccountID(ctx, accountID)
+	}
+
+	partner, err := findPartner(ctx, clientRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	if lock {
+		return walletRepo.FindOwnedByAccountIDForUpdate(ctx, partner.ID, accountID)
+	}
+	return walletRepo.FindOwnedByAccountID(ctx, partner.ID, accountID)
+}
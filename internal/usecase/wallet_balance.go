@@ -36,14 +36,24 @@ func (uc *WalletBalanceUseCase) Execute(ctx context.Context, req *request.GetBal
 	}
 
 	// Find wallet
-	wallet, err := uc.walletRepo.FindByAccountID(ctx, accountID)
+	wallet, err := uc.walletRepo.FindOwnedByAccountID(ctx, req.ClientID, accountID)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := wallet.EnsureAccessible(); err != nil {
+		return nil, err
+	}
+
 	return &response.GetBalanceResponse{
-		AccountID: accountID.Value(),
-		Balance:   wallet.Balance.Dirams(),
-		Currency:  valueobject.CurrencyTJS,
+		AccountID:        accountID.Value(),
+		Balance:          wallet.Balance.Dirams(),
+		AvailableBalance: wallet.AvailableBalance().Dirams(),
+		HeldAmount:       wallet.HeldAmount.Dirams(),
+		Currency:         valueobject.CurrencyTJS,
+
+		Status:               wallet.Status.String(),
+		WalletType:           wallet.Type.String(),
+		IdentificationStatus: wallet.IdentificationStatus.String(),
 	}, nil
 }
@@ -8,6 +8,7 @@ import (
 	"e-wallet/internal/dto/response"
 	apperrors "e-wallet/pkg/errors"
 	"e-wallet/pkg/validator"
+	"errors"
 )
 
 type WalletCheckUseCase struct {
@@ -31,18 +32,18 @@ func (uc *WalletCheckUseCase) Execute(ctx context.Context, req *request.CheckWal
 		return nil, apperrors.ErrInvalidRequest
 	}
 
-	exists, err := uc.walletRepo.ExistsByAccountID(ctx, accountID)
+	// Wallets of other clients are reported as non-existent
+	wallet, err := uc.walletRepo.FindOwnedByAccountID(ctx, req.ClientID, accountID)
 	if err != nil {
+		if errors.Is(err, apperrors.ErrWalletNotFound) {
+			return &response.CheckWalletResponse{Exists: false}, nil
+		}
 		return nil, err
 	}
 
-	resp := &response.CheckWalletResponse{
-		Exists: exists,
-	}
-
-	if exists {
-		resp.AccountID = req.AccountID
-	}
-
-	return resp, nil
+	return &response.CheckWalletResponse{
+		Exists:    true,
+		AccountID: accountID.Value(),
+		Status:    wallet.Status.String(),
+	}, nil
 }
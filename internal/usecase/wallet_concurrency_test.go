@@ -0,0 +1,372 @@
+package usecase_test
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/service"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/internal/repository/postgres"
+	"e-wallet/internal/usecase"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	postgresdriver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// These tests run deposits and withdrawals in parallel against a real PostgreSQL database:
+//
+//	TEST_DATABASE_DSN="host=localhost port=5432 user=postgres password=postgres dbname=e_wallet_db sslmode=disable" \
+//	    go test ./internal/usecase/ -run Concurrent
+//
+// Each test creates its own partner and wallets. They are skipped when TEST_DATABASE_DSN is not set.
+
+// Wallet tiers registered for the tests
+const (
+	tierCapped  valueobject.WalletType = "test_capped"  // 1,000 TJS balance limit
+	tierCounted valueobject.WalletType = "test_counted" // 10 incoming operations per day
+	tierOpen    valueobject.WalletType = "test_open"    // no limits in the way
+)
+
+type concurrencyEnv struct {
+	db         *gorm.DB
+	walletRepo *postgres.WalletRepository
+	ledger     *usecase.LedgerUseCase
+	deposit    *usecase.WalletDepositUseCase
+	withdraw   *usecase.WalletWithdrawUseCase
+	reverse    *usecase.TransactionReverseUseCase
+	clientID   int64
+	suffix     string
+}
+
+func newConcurrencyEnv(t *testing.T) *concurrencyEnv {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+
+	discard := log.New(io.Discard, "", 0)
+	logger.Info, logger.Error, logger.Warning, logger.Debug, logger.GORMLog = discard, discard, discard, discard, discard
+
+	db, err := gorm.Open(postgresdriver.Open(dsn), &gorm.Config{Logger: logger.NewGORMLogger(discard)})
+	if err != nil {
+		t.Fatalf("connect to database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("get database instance: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(20)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	if err := database.RunMigrations(db); err != nil {
+		t.Fatalf("run migrations: %v", err)
+	}
+
+	valueobject.RegisterWalletTiers([]valueobject.WalletTier{
+		{Type: tierCapped, Name: "Capped", MaxBalance: mustMoney(t, 100000)},
+		{Type: tierCounted, Name: "Counted", MaxBalance: mustMoney(t, 10000000), Limits: valueobject.TurnoverLimits{DailyCount: 10}},
+		{Type: tierOpen, Name: "Open", MaxBalance: mustMoney(t, 100000000)},
+	})
+
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	partner := &models.APIClient{UserID: "concurrency_" + suffix, SecretKey: "concurrency_secret", IsActive: true}
+	if err := db.Create(partner).Error; err != nil {
+		t.Fatalf("create partner: %v", err)
+	}
+
+	// An empty schedule of its own keeps the partner's operations free whatever the default schedule charges
+	freeSchedule := &models.FeeSchedule{ClientID: &partner.ID, EffectiveFrom: time.Now().Add(-time.Hour), CreatedBy: partner.ID}
+	if err := db.Create(freeSchedule).Error; err != nil {
+		t.Fatalf("create fee schedule: %v", err)
+	}
+
+	walletRepo := postgres.NewWalletRepository(db)
+	transactionRepo := postgres.NewTransactionRepository(db)
+	clientRepo := postgres.NewClientRepository(db)
+	ledgerRepo := postgres.NewLedgerRepository(db)
+
+	balanceValidator := service.NewBalanceValidator(transactionRepo, time.UTC)
+	idempotency := usecase.NewIdempotencyUseCase(postgres.NewIdempotencyRepository(db), nil)
+	ledger := usecase.NewLedgerUseCase(ledgerRepo)
+	fees := usecase.NewFeeUseCase(postgres.NewFeeScheduleRepository(db), transactionRepo, ledger)
+	webhooks := usecase.NewWebhookPublisher(
+		postgres.NewWebhookSubscriptionRepository(db),
+		postgres.NewWebhookOutboxRepository(db),
+	)
+
+	// Fund the partner's float well above anything the tests deposit
+	topUp := usecase.NewFloatTopUpUseCase(db, clientRepo, ledgerRepo, ledger)
+	_, err = topUp.Execute(context.Background(), &request.TopUpFloatRequest{
+		UserID:    partner.UserID,
+		Amount:    100000000,
+		Reference: "concurrency-" + suffix,
+	})
+	if err != nil {
+		t.Fatalf("top up float: %v", err)
+	}
+
+	return &concurrencyEnv{
+		db:         db,
+		walletRepo: walletRepo,
+		ledger:     ledger,
+		deposit: usecase.NewWalletDepositUseCase(
+			db, walletRepo, transactionRepo, balanceValidator, ledger, fees, webhooks, idempotency,
+		),
+		withdraw: usecase.NewWalletWithdrawUseCase(
+			db, walletRepo, transactionRepo, balanceValidator, ledger, fees, idempotency,
+		),
+		reverse:  usecase.NewTransactionReverseUseCase(db, walletRepo, transactionRepo, ledger, fees, idempotency),
+		clientID: partner.ID,
+		suffix:   suffix,
+	}
+}
+
+func mustMoney(t *testing.T, dirams int64) valueobject.Money {
+	t.Helper()
+	money, err := valueobject.NewMoney(dirams)
+	if err != nil {
+		t.Fatalf("money %d: %v", dirams, err)
+	}
+	return money
+}
+
+// createWallet opens an empty wallet of the partner
+func (env *concurrencyEnv) createWallet(t *testing.T, name string, walletType valueobject.WalletType) *entity.Wallet {
+	t.Helper()
+	accountID, err := valueobject.NewAccountID(name + "_" + env.suffix)
+	if err != nil {
+		t.Fatalf("account ID: %v", err)
+	}
+	wallet := entity.NewWallet(accountID, walletType, env.clientID)
+	if err := env.walletRepo.Create(context.Background(), wallet); err != nil {
+		t.Fatalf("create wallet: %v", err)
+	}
+	return wallet
+}
+
+// reload reads the wallet back and checks that its balance matches its ledger account
+func (env *concurrencyEnv) reload(t *testing.T, wallet *entity.Wallet) *entity.Wallet {
+	t.Helper()
+	ctx := context.Background()
+	stored, err := env.walletRepo.FindByID(ctx, wallet.ID)
+	if err != nil {
+		t.Fatalf("reload wallet: %v", err)
+	}
+	account, err := env.ledger.WalletAccount(ctx, stored)
+	if err != nil {
+		t.Fatalf("wallet ledger account: %v", err)
+	}
+	if err := env.ledger.VerifyWallet(ctx, stored, account.ID); err != nil {
+		t.Fatalf("wallet balance %d does not match the ledger: %v", stored.Balance.Dirams(), err)
+	}
+	return stored
+}
+
+// countTransactions counts the wallet's transactions of one type
+func (env *concurrencyEnv) countTransactions(t *testing.T, wallet *entity.Wallet, txType entity.TransactionType) int64 {
+	t.Helper()
+	var count int64
+	err := env.db.Model(&models.Transaction{}).
+		Where("wallet_id = ? AND type = ?", wallet.ID, string(txType)).
+		Count(&count).Error
+	if err != nil {
+		t.Fatalf("count transactions: %v", err)
+	}
+	return count
+}
+
+// operationResult is the outcome of one operation fired in parallel
+type operationResult struct {
+	deposit bool
+	amount  int64 // moved by the operation (dirams)
+	err     error
+}
+
+// runParallel starts every operation at the same moment and waits for all of them
+func runParallel(operations []func() operationResult) []operationResult {
+	results := make([]operationResult, len(operations))
+	start := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i, operation := range operations {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			results[i] = operation()
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	return results
+}
+
+func (env *concurrencyEnv) depositOperation(wallet *entity.Wallet, amount int64) func() operationResult {
+	return func() operationResult {
+		resp, err := env.deposit.Execute(context.Background(), &request.DepositRequest{
+			AccountID: wallet.AccountID.Value(),
+			Amount:    amount,
+			ClientID:  env.clientID,
+		})
+		if err != nil {
+			return operationResult{deposit: true, err: err}
+		}
+		return operationResult{deposit: true, amount: resp.Amount}
+	}
+}
+
+func (env *concurrencyEnv) withdrawOperation(wallet *entity.Wallet, amount int64) func() operationResult {
+	return func() operationResult {
+		resp, err := env.withdraw.Execute(context.Background(), &request.WithdrawRequest{
+			AccountID: wallet.AccountID.Value(),
+			Amount:    amount,
+			ClientID:  env.clientID,
+		})
+		if err != nil {
+			return operationResult{err: err}
+		}
+		return operationResult{amount: resp.Amount}
+	}
+}
+
+// settle sums the balance change of the successful operations and checks every failure is one of allowed
+func settle(t *testing.T, results []operationResult, allowed ...error) (change int64, deposits, withdrawals int64) {
+	t.Helper()
+	for _, result := range results {
+		if result.err != nil {
+			if !isOneOf(result.err, allowed) {
+				t.Errorf("unexpected error: %v", result.err)
+			}
+			continue
+		}
+		if result.deposit {
+			deposits++
+			change += result.amount
+		} else {
+			withdrawals++
+			change -= result.amount
+		}
+	}
+	return change, deposits, withdrawals
+}
+
+func isOneOf(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestConcurrentDepositsStayWithinBalanceLimit(t *testing.T) {
+	env := newConcurrencyEnv(t)
+	wallet := env.createWallet(t, "capped", tierCapped)
+
+	// 300 deposits of 5 TJS against a 1,000 TJS limit: exactly 200 fit
+	operations := make([]func() operationResult, 300)
+	for i := range operations {
+		operations[i] = env.depositOperation(wallet, 500)
+	}
+	results := runParallel(operations)
+
+	change, deposits, _ := settle(t, results, apperrors.ErrBalanceExceedsLimit)
+	stored := env.reload(t, wallet)
+
+	if deposits != 200 {
+		t.Errorf("%d deposits succeeded, want exactly the 200 that fit under the limit", deposits)
+	}
+	if stored.Balance.Dirams() != change {
+		t.Errorf("balance = %d, want %d from %d successful deposits (lost update)", stored.Balance.Dirams(), change, deposits)
+	}
+	if stored.Balance.Dirams() > 100000 {
+		t.Errorf("balance = %d exceeds the limit of 100000", stored.Balance.Dirams())
+	}
+	if recorded := env.countTransactions(t, wallet, entity.TransactionTypeDeposit); recorded != deposits {
+		t.Errorf("%d deposit transactions recorded, want %d", recorded, deposits)
+	}
+}
+
+func TestConcurrentDepositsStayWithinDailyCount(t *testing.T) {
+	env := newConcurrencyEnv(t)
+	wallet := env.createWallet(t, "counted", tierCounted)
+
+	operations := make([]func() operationResult, 300)
+	for i := range operations {
+		operations[i] = env.depositOperation(wallet, 1000)
+	}
+	results := runParallel(operations)
+
+	change, deposits, _ := settle(t, results, apperrors.ErrDailyCountLimit)
+	stored := env.reload(t, wallet)
+
+	if deposits != 10 {
+		t.Errorf("%d deposits succeeded, want exactly the daily count of 10", deposits)
+	}
+	if stored.Balance.Dirams() != change {
+		t.Errorf("balance = %d, want %d from %d successful deposits (lost update)", stored.Balance.Dirams(), change, deposits)
+	}
+}
+
+func TestConcurrentDepositsAndWithdrawalsLoseNoUpdates(t *testing.T) {
+	env := newConcurrencyEnv(t)
+	wallet := env.createWallet(t, "mixed", tierOpen)
+
+	initial := runParallel([]func() operationResult{env.depositOperation(wallet, 100000)})
+	opening, _, _ := settle(t, initial)
+
+	// The wallet never holds more than the 1,000 TJS opening balance plus 300 deposits of 10 TJS,
+	// 4,000 TJS in total, so at most 100 of the 300 withdrawals of 40 TJS can be paid
+	const count, depositAmount, withdrawalAmount = 300, 1000, 4000
+	operations := make([]func() operationResult, 0, 2*count)
+	for i := 0; i < count; i++ {
+		operations = append(operations, env.depositOperation(wallet, depositAmount), env.withdrawOperation(wallet, withdrawalAmount))
+	}
+	results := runParallel(operations)
+
+	change, deposits, withdrawals := settle(t, results, apperrors.ErrInsufficientFunds)
+	stored := env.reload(t, wallet)
+
+	refused := 0
+	for _, result := range results {
+		if errors.Is(result.err, apperrors.ErrInsufficientFunds) {
+			refused++
+		}
+	}
+	if deposits != count {
+		t.Errorf("%d deposits succeeded, want all %d", deposits, count)
+	}
+	if maxPaid := (opening + count*depositAmount) / withdrawalAmount; withdrawals > maxPaid {
+		t.Errorf("%d withdrawals paid, but the funds cover at most %d", withdrawals, maxPaid)
+	}
+	if refused != count-int(withdrawals) {
+		t.Errorf("%d withdrawals refused with insufficient funds, want %d", refused, count-int(withdrawals))
+	}
+
+	want := opening + deposits*depositAmount - withdrawals*withdrawalAmount
+	if stored.Balance.Dirams() != want || opening+change != want {
+		t.Errorf("balance = %d, want %d after %d deposits and %d withdrawals (lost update)",
+			stored.Balance.Dirams(), want, deposits, withdrawals)
+	}
+	if stored.Balance.Dirams() < 0 {
+		t.Errorf("balance = %d went negative", stored.Balance.Dirams())
+	}
+	if recorded := env.countTransactions(t, wallet, entity.TransactionTypeWithdrawal); recorded != withdrawals {
+		t.Errorf("%d withdrawal transactions recorded, want %d", recorded, withdrawals)
+	}
+}
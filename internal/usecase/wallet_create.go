@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+)
+
+// WalletCreateUseCase handles opening new wallets
+type WalletCreateUseCase struct {
+	walletRepo repository.WalletRepository
+}
+
+// NewWalletCreateUseCase creates a new WalletCreateUseCase
+func NewWalletCreateUseCase(walletRepo repository.WalletRepository) *WalletCreateUseCase {
+	return &WalletCreateUseCase{
+		walletRepo: walletRepo,
+	}
+}
+
+// Execute opens an empty unidentified wallet owned by the calling client.
+// Higher tiers are granted only by an approved identification (see IdentificationReviewUseCase).
+func (uc *WalletCreateUseCase) Execute(ctx context.Context, req *request.CreateWalletRequest) (*response.CreateWalletResponse, error) {
+	// Validate request
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	// Create value objects
+	accountID, err := valueobject.NewAccountID(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	walletType := valueobject.WalletTypeUnidentified
+	if req.WalletType != "" {
+		walletType, err = valueobject.NewWalletType(req.WalletType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if walletType.RequiresIdentification() {
+		return nil, apperrors.ErrIdentificationNeeded
+	}
+
+	wallet := entity.NewWallet(accountID, walletType, req.ClientID)
+
+	// Account IDs are unique per partner: the unique index on (account_id, owner_client_id)
+	// turns the client's own duplicates into ErrAlreadyExists, while other partners' wallets do not conflict
+	if err := uc.walletRepo.Create(ctx, wallet); err != nil {
+		return nil, err
+	}
+
+	logger.Info.Printf("Wallet %s (%s) created for client_id %d, wallet ID: %d",
+		accountID.Value(), walletType, req.ClientID, wallet.ID)
+
+	return &response.CreateWalletResponse{
+		Success:    true,
+		AccountID:  accountID.Value(),
+		WalletType: walletType.String(),
+		Balance:    wallet.Balance.Dirams(),
+		Currency:   valueobject.CurrencyTJS,
+		CreatedAt:  wallet.CreatedAt,
+	}, nil
+}
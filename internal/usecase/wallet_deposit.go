@@ -12,7 +12,9 @@ import (
 	"e-wallet/internal/infrastructure/logger"
 	apperrors "e-wallet/pkg/errors"
 	"e-wallet/pkg/validator"
+	"errors"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -22,6 +24,10 @@ type WalletDepositUseCase struct {
 	walletRepo       repository.WalletRepository
 	transactionRepo  repository.TransactionRepository
 	balanceValidator *service.BalanceValidator
+	ledger           *LedgerUseCase
+	fees             *FeeUseCase
+	webhooks         *WebhookPublisher
+	idempotency      *IdempotencyUseCase
 }
 
 // NewWalletDepositUseCase creates a new WalletDepositUseCase
@@ -30,12 +36,20 @@ func NewWalletDepositUseCase(
 	walletRepo repository.WalletRepository,
 	transactionRepo repository.TransactionRepository,
 	balanceValidator *service.BalanceValidator,
+	ledger *LedgerUseCase,
+	fees *FeeUseCase,
+	webhooks *WebhookPublisher,
+	idempotency *IdempotencyUseCase,
 ) *WalletDepositUseCase {
 	return &WalletDepositUseCase{
 		db:               db,
 		walletRepo:       walletRepo,
 		transactionRepo:  transactionRepo,
 		balanceValidator: balanceValidator,
+		ledger:           ledger,
+		fees:             fees,
+		webhooks:         webhooks,
+		idempotency:      idempotency,
 	}
 }
 
@@ -57,21 +71,56 @@ func (uc *WalletDepositUseCase) Execute(ctx context.Context, req *request.Deposi
 		return nil, apperrors.ErrInvalidAmount
 	}
 
+	// Return the stored response if this is a retry of a completed request
+	if req.IdempotencyKey != "" {
+		var replayed response.DepositResponse
+		found, err := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationDeposit, req, &replayed)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return &replayed, nil
+		}
+	}
+
 	// Start transaction
 	var resp *response.DepositResponse
 	err = uc.db.Transaction(func(tx *gorm.DB) error {
 		txCtx := database.InjectTx(ctx, tx)
 
-		wallet, err := uc.walletRepo.FindByAccountID(txCtx, accountID)
+		// Lock the wallet row so concurrent operations cannot overwrite each other's balance
+		wallet, err := uc.walletRepo.FindOwnedByAccountIDForUpdate(txCtx, req.ClientID, accountID)
 		if err != nil {
 			return err
 		}
 
+		if req.ExternalID != "" {
+			if err := uc.ensureNewExternalID(txCtx, req.ClientID, req.ExternalID); err != nil {
+				return err
+			}
+		}
+
 		logger.Info.Printf("Depositing %d dirams to wallet %s (current balance: %d dirams)",
 			amount.Dirams(), accountID.Value(), wallet.Balance.Dirams())
 
 		// Validate deposit
-		if err := uc.balanceValidator.ValidateDeposit(wallet, amount); err != nil {
+		if err := uc.balanceValidator.ValidateDeposit(txCtx, wallet, amount); err != nil {
+			return err
+		}
+
+		// The fee is the partner's commission, drawn from its float on top of the deposited amount
+		quote, err := uc.fees.Quote(txCtx, req.ClientID, entity.FeeOperationDeposit, wallet, amount)
+		if err != nil {
+			return err
+		}
+
+		// Open the ledger accounts before the balance changes
+		walletAccount, err := uc.ledger.WalletAccount(txCtx, wallet)
+		if err != nil {
+			return err
+		}
+		floatAccount, err := uc.ledger.DrawPartnerFloat(txCtx, req.ClientID, amount)
+		if err != nil {
 			return err
 		}
 
@@ -86,30 +135,130 @@ func (uc *WalletDepositUseCase) Execute(ctx context.Context, req *request.Deposi
 		}
 
 		// Create transaction record
-		transaction := entity.NewTransaction(wallet.ID, entity.TransactionTypeDeposit, amount)
+		transaction := entity.NewTransaction(wallet.ID, req.ClientID, entity.TransactionTypeDeposit, amount, wallet.Balance)
+		transaction.Reference = uuid.New().String()
+		transaction.ExternalID = req.ExternalID
+		transaction.Description = req.Description
+		transaction.Metadata = req.Metadata
 		if err := uc.transactionRepo.Create(txCtx, transaction); err != nil {
+			// A concurrent deposit with the same external ID committed first
+			if req.ExternalID != "" && errors.Is(err, apperrors.ErrAlreadyExists) {
+				return apperrors.ErrDuplicateExternalID
+			}
+			return err
+		}
+
+		// Money comes from the partner's float into the wallet
+		if err := uc.ledger.Move(txCtx, entity.JournalEntryKindDeposit, transaction.Reference, floatAccount, walletAccount, amount); err != nil {
+			return err
+		}
+		feeTransaction, err := uc.fees.Charge(txCtx, wallet, req.ClientID, transaction.Reference, quote)
+		if err != nil {
+			return err
+		}
+		if err := uc.ledger.VerifyWallet(txCtx, wallet, walletAccount.ID); err != nil {
 			return err
 		}
 
 		logger.Info.Printf("Deposit successful. New balance: %d dirams, Transaction ID: %d",
 			wallet.Balance.Dirams(), transaction.ID)
 
+		// Events are written to the outbox in this DB transaction and delivered after it commits
+		if err := uc.publishEvents(txCtx, wallet, transaction); err != nil {
+			return err
+		}
+
 		// Build response
 		resp = &response.DepositResponse{
 			Success:       true,
 			AccountID:     accountID.Value(),
 			Amount:        amount.Dirams(),
+			Fee:           quote.Fee.Dirams(),
+			NetAmount:     amount.Dirams() - quote.Fee.Dirams(),
 			NewBalance:    wallet.Balance.Dirams(),
 			Currency:      valueobject.CurrencyTJS,
 			TransactionID: transaction.ID,
 		}
+		if feeTransaction != nil {
+			resp.FeeTransactionID = &feeTransaction.ID
+		}
+
+		// Store the response in the same DB transaction as the operation
+		if req.IdempotencyKey != "" {
+			if err := uc.idempotency.Save(txCtx, req.ClientID, req.IdempotencyKey, OperationDeposit, req, resp); err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
 
 	if err != nil {
+		// A concurrent retry with the same key committed first; replay its response.
+		// The retry carries the same external ID, so it may be the external ID that collided.
+		if req.IdempotencyKey != "" && (errors.Is(err, apperrors.ErrAlreadyExists) || errors.Is(err, apperrors.ErrDuplicateExternalID)) {
+			var replayed response.DepositResponse
+			found, replayErr := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationDeposit, req, &replayed)
+			if replayErr != nil {
+				return nil, replayErr
+			}
+			if found {
+				return &replayed, nil
+			}
+		}
 		return nil, err
 	}
 
 	return resp, nil
 }
+
+// ensureNewExternalID rejects an external ID the client has already used
+func (uc *WalletDepositUseCase) ensureNewExternalID(ctx context.Context, clientID int64, externalID string) error {
+	existing, err := uc.transactionRepo.FindByExternalID(ctx, clientID, externalID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrTransactionNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	logger.Warning.Printf("[usecase.WalletDeposit]: Client %d reused external_id of transaction %d", clientID, existing.ID)
+	return apperrors.ErrDuplicateExternalID
+}
+
+// publishEvents queues deposit.completed for the wallet owner, and wallet.limit_reached
+// when this deposit left the wallet unable to accept any further deposit
+func (uc *WalletDepositUseCase) publishEvents(ctx context.Context, wallet *entity.Wallet, transaction *entity.Transaction) error {
+	err := uc.webhooks.Publish(ctx, wallet.OwnerClientID, entity.WebhookEventDepositCompleted, &response.DepositCompletedEvent{
+		AccountID:     wallet.AccountID.Value(),
+		TransactionID: transaction.ID,
+		ExternalID:    transaction.ExternalID,
+		Amount:        transaction.Amount.Dirams(),
+		NewBalance:    wallet.Balance.Dirams(),
+		Currency:      valueobject.CurrencyTJS,
+	})
+	if err != nil {
+		return err
+	}
+
+	subscribed, err := uc.webhooks.Subscribed(ctx, wallet.OwnerClientID, entity.WebhookEventWalletLimitReached)
+	if err != nil || !subscribed {
+		return err
+	}
+
+	headroom, err := uc.balanceValidator.GetDepositHeadroom(ctx, wallet)
+	if err != nil {
+		return err
+	}
+	if headroom.MaxDeposit.Amount() > 0 {
+		return nil
+	}
+
+	return uc.webhooks.Publish(ctx, wallet.OwnerClientID, entity.WebhookEventWalletLimitReached, &response.WalletLimitReachedEvent{
+		AccountID:  wallet.AccountID.Value(),
+		WalletType: wallet.Type.String(),
+		Balance:    wallet.Balance.Dirams(),
+		MaxBalance: headroom.MaxBalance.Dirams(),
+		Currency:   valueobject.CurrencyTJS,
+	})
+}
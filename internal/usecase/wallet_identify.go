@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WalletIdentifyUseCase handles KYC submissions that start wallet identification
+type WalletIdentifyUseCase struct {
+	db                 *gorm.DB
+	walletRepo         repository.WalletRepository
+	identificationRepo repository.IdentificationRepository
+}
+
+// NewWalletIdentifyUseCase creates a new WalletIdentifyUseCase
+func NewWalletIdentifyUseCase(
+	db *gorm.DB,
+	walletRepo repository.WalletRepository,
+	identificationRepo repository.IdentificationRepository,
+) *WalletIdentifyUseCase {
+	return &WalletIdentifyUseCase{
+		db:                 db,
+		walletRepo:         walletRepo,
+		identificationRepo: identificationRepo,
+	}
+}
+
+// Execute stores the KYC data and moves the wallet to pending identification
+func (uc *WalletIdentifyUseCase) Execute(ctx context.Context, req *request.IdentifyWalletRequest) (*response.IdentifyWalletResponse, error) {
+	// Validate request
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	accountID, err := valueobject.NewAccountID(req.AccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	dateOfBirth, err := time.Parse(dateLayout, req.DateOfBirth)
+	if err != nil || !dateOfBirth.Before(time.Now()) {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	var resp *response.IdentifyWalletResponse
+	err = uc.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		// Lock the wallet so concurrent submissions cannot both start a review
+		wallet, err := uc.walletRepo.FindOwnedByAccountIDForUpdate(txCtx, req.ClientID, accountID)
+		if err != nil {
+			return err
+		}
+
+		if err := wallet.EnsureAccessible(); err != nil {
+			return err
+		}
+
+		previousStatus := wallet.IdentificationStatus
+		if err := wallet.SubmitIdentification(); err != nil {
+			return err
+		}
+
+		if err := uc.walletRepo.Update(txCtx, wallet); err != nil {
+			return err
+		}
+
+		submission := entity.NewKYCSubmission(wallet.ID, req.ClientID, strings.TrimSpace(req.FullName),
+			req.DocumentType, strings.ToUpper(req.DocumentNumber), dateOfBirth)
+		if err := uc.identificationRepo.CreateSubmission(txCtx, submission); err != nil {
+			return err
+		}
+
+		event := entity.NewIdentificationEvent(wallet.ID, submission.ID, previousStatus, wallet.IdentificationStatus, req.ClientID, "")
+		if err := uc.identificationRepo.CreateEvent(txCtx, event); err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Identification submitted for wallet %s, submission ID: %d", accountID.Value(), submission.ID)
+
+		resp = &response.IdentifyWalletResponse{
+			Success:              true,
+			AccountID:            accountID.Value(),
+			IdentificationStatus: wallet.IdentificationStatus.String(),
+			SubmissionID:         submission.ID,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/service"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+)
+
+// WalletLimitsUseCase reports the remaining balance and turnover headroom of a wallet
+type WalletLimitsUseCase struct {
+	walletRepo       repository.WalletRepository
+	balanceValidator *service.BalanceValidator
+}
+
+// NewWalletLimitsUseCase creates a new WalletLimitsUseCase
+func NewWalletLimitsUseCase(walletRepo repository.WalletRepository, balanceValidator *service.BalanceValidator) *WalletLimitsUseCase {
+	return &WalletLimitsUseCase{
+		walletRepo:       walletRepo,
+		balanceValidator: balanceValidator,
+	}
+}
+
+// Execute returns the wallet's limits and how much of them is left right now.
+// The figures are a snapshot; a deposit is still checked against the limits when it is made.
+func (uc *WalletLimitsUseCase) Execute(ctx context.Context, req *request.GetLimitsRequest) (*response.GetLimitsResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	accountID, err := valueobject.NewAccountID(req.AccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	wallet, err := uc.walletRepo.FindOwnedByAccountID(ctx, req.ClientID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wallet.EnsureAccessible(); err != nil {
+		return nil, err
+	}
+
+	headroom, err := uc.balanceValidator.GetDepositHeadroom(ctx, wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, turnover := headroom.Limits, headroom.Turnover
+
+	return &response.GetLimitsResponse{
+		AccountID:    accountID.Value(),
+		WalletType:   wallet.Type.String(),
+		Balance:      wallet.Balance.Dirams(),
+		MaxBalance:   headroom.MaxBalance.Dirams(),
+		MaxOperation: limits.MaxOperation,
+		MaxDeposit:   headroom.MaxDeposit.Dirams(),
+		Currency:     valueobject.CurrencyTJS,
+		Daily: response.TurnoverHeadroom{
+			Count:           turnover.DailyCount,
+			Amount:          turnover.DailyAmount,
+			CountLimit:      limits.DailyCount,
+			AmountLimit:     limits.DailyAmount,
+			CountRemaining:  remainingLimit(limits.DailyCount, turnover.DailyCount),
+			AmountRemaining: remainingLimit(limits.DailyAmount, turnover.DailyAmount),
+		},
+		Monthly: response.TurnoverHeadroom{
+			Count:           turnover.MonthlyCount,
+			Amount:          turnover.MonthlyAmount,
+			CountLimit:      limits.MonthlyCount,
+			AmountLimit:     limits.MonthlyAmount,
+			CountRemaining:  remainingLimit(limits.MonthlyCount, turnover.MonthlyCount),
+			AmountRemaining: remainingLimit(limits.MonthlyAmount, turnover.MonthlyAmount),
+		},
+	}, nil
+}
+
+// remainingLimit returns what is left of a limit, or nil when the limit is not enforced
+func remainingLimit(limit, used int64) *int64 {
+	if limit == 0 {
+		return nil
+	}
+	remaining := max(limit-used, 0)
+	return &remaining
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"e-wallet/internal/domain/entity"
 	"e-wallet/internal/domain/repository"
 	"e-wallet/internal/domain/valueobject"
 	"e-wallet/internal/dto/request"
@@ -9,24 +10,36 @@ import (
 	apperrors "e-wallet/pkg/errors"
 	"e-wallet/pkg/utils"
 	"e-wallet/pkg/validator"
+	"time"
+)
+
+const (
+	monthLayout = "2006-01"
+	dateLayout  = "2006-01-02"
+
+	// maxStatsPeriod bounds custom ranges so a single request cannot scan years of history
+	maxStatsPeriod = 366 * 24 * time.Hour
 )
 
 type WalletMonthlyStatsUseCase struct {
 	walletRepo      repository.WalletRepository
 	transactionRepo repository.TransactionRepository
+	location        *time.Location // business time zone for day and month boundaries
 }
 
 func NewWalletMonthlyStatsUseCase(
 	walletRepo repository.WalletRepository,
 	transactionRepo repository.TransactionRepository,
+	location *time.Location,
 ) *WalletMonthlyStatsUseCase {
 	return &WalletMonthlyStatsUseCase{
 		walletRepo:      walletRepo,
 		transactionRepo: transactionRepo,
+		location:        location,
 	}
 }
 
-// Execute retrieves monthly statistics for a wallet
+// Execute retrieves statistics for a wallet over a month or a date range
 func (uc *WalletMonthlyStatsUseCase) Execute(ctx context.Context, req *request.GetMonthlyStatsRequest) (*response.MonthlyStatsResponse, error) {
 	if err := validator.Validate(req); err != nil {
 		return nil, apperrors.ErrValidationFailed
@@ -37,23 +50,99 @@ func (uc *WalletMonthlyStatsUseCase) Execute(ctx context.Context, req *request.G
 		return nil, apperrors.ErrInvalidRequest
 	}
 
-	wallet, err := uc.walletRepo.FindByAccountID(ctx, accountID)
+	from, to, month, err := resolveStatsPeriod(req, uc.location)
+	if err != nil {
+		return nil, err
+	}
+
+	groupBy := repository.StatsGroupDay
+	if req.GroupBy != "" {
+		groupBy = repository.StatsGrouping(req.GroupBy)
+	}
+
+	wallet, err := uc.walletRepo.FindOwnedByAccountID(ctx, req.ClientID, accountID)
 	if err != nil {
 		return nil, err
 	}
 
-	currentMonth := utils.GetCurrentMonth()
+	if err := wallet.EnsureAccessible(); err != nil {
+		return nil, err
+	}
 
-	stats, err := uc.transactionRepo.GetMonthlyStats(ctx, wallet.ID, currentMonth)
+	stats, err := uc.transactionRepo.GetPeriodStats(ctx, wallet.ID, from, to, groupBy, uc.location)
 	if err != nil {
 		return nil, err
 	}
 
-	return &response.MonthlyStatsResponse{
-		AccountID:   accountID.Value(),
-		Month:       currentMonth.Format("2006-01"),
-		TotalCount:  stats.TotalCount,
-		TotalAmount: stats.TotalAmount,
-		Currency:    valueobject.CurrencyTJS,
-	}, nil
+	resp := &response.MonthlyStatsResponse{
+		AccountID: accountID.Value(),
+		Month:     month,
+		Currency:  valueobject.CurrencyTJS,
+		From:      from.Format(dateLayout),
+		To:        to.AddDate(0, 0, -1).Format(dateLayout),
+		GroupBy:   string(groupBy),
+		ByType:    make([]response.TypeStats, 0),
+		Breakdown: make([]response.PeriodStats, 0),
+	}
+
+	// Rows arrive ordered by period, then type
+	typeIndex := make(map[string]int)
+	for _, row := range stats {
+		if row.Type == entity.TransactionTypeDeposit {
+			resp.TotalCount += row.TotalCount
+			resp.TotalAmount += row.TotalAmount
+		}
+
+		item := response.TypeStats{Type: string(row.Type), Count: row.TotalCount, Amount: row.TotalAmount}
+
+		if i, ok := typeIndex[item.Type]; ok {
+			resp.ByType[i].Count += item.Count
+			resp.ByType[i].Amount += item.Amount
+		} else {
+			typeIndex[item.Type] = len(resp.ByType)
+			resp.ByType = append(resp.ByType, item)
+		}
+
+		period := row.Period.Format(dateLayout)
+		if n := len(resp.Breakdown); n == 0 || resp.Breakdown[n-1].Period != period {
+			resp.Breakdown = append(resp.Breakdown, response.PeriodStats{Period: period})
+		}
+		last := &resp.Breakdown[len(resp.Breakdown)-1]
+		last.ByType = append(last.ByType, item)
+	}
+
+	return resp, nil
+}
+
+// resolveStatsPeriod turns the request into a half-open [from, to) range of midnights in loc.
+// month is set when the range is a calendar month.
+func resolveStatsPeriod(req *request.GetMonthlyStatsRequest, loc *time.Location) (time.Time, time.Time, string, error) {
+	switch {
+	case req.From != "":
+		firstDay, err := time.ParseInLocation(dateLayout, req.From, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, "", apperrors.ErrInvalidPeriod
+		}
+		lastDay, err := time.ParseInLocation(dateLayout, req.To, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, "", apperrors.ErrInvalidPeriod
+		}
+		from, to := utils.DayRange(firstDay, lastDay)
+		if !from.Before(to) || to.Sub(from) > maxStatsPeriod {
+			return time.Time{}, time.Time{}, "", apperrors.ErrInvalidPeriod
+		}
+		return from, to, "", nil
+
+	case req.Month != "":
+		month, err := time.ParseInLocation(monthLayout, req.Month, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, "", apperrors.ErrInvalidPeriod
+		}
+		from, to := utils.MonthRange(month)
+		return from, to, req.Month, nil
+
+	default:
+		from, to := utils.MonthRange(utils.GetCurrentMonth(loc))
+		return from, to, from.Format(monthLayout), nil
+	}
 }
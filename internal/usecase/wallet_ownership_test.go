@@ -0,0 +1,54 @@
+package usecase_test
+
+import (
+	"context"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/infrastructure/database/models"
+	"e-wallet/internal/usecase"
+	apperrors "e-wallet/pkg/errors"
+	"errors"
+	"testing"
+)
+
+// TestAccountIDIsUniquePerPartner checks that a partner cannot learn from wallet creation whether another
+// partner already has a customer with the same account ID, and that each partner only sees its own wallet.
+func TestAccountIDIsUniquePerPartner(t *testing.T) {
+	env := newConcurrencyEnv(t)
+	ctx := context.Background()
+
+	competitor := &models.APIClient{UserID: "competitor_" + env.suffix, SecretKey: "competitor_secret", IsActive: true}
+	if err := env.db.Create(competitor).Error; err != nil {
+		t.Fatalf("create competitor: %v", err)
+	}
+
+	create := usecase.NewWalletCreateUseCase(env.walletRepo)
+	balance := usecase.NewWalletBalanceUseCase(env.walletRepo)
+	accountID := "shared_" + env.suffix
+
+	if _, err := create.Execute(ctx, &request.CreateWalletRequest{AccountID: accountID, ClientID: env.clientID}); err != nil {
+		t.Fatalf("create wallet: %v", err)
+	}
+	if _, err := create.Execute(ctx, &request.CreateWalletRequest{AccountID: accountID, ClientID: env.clientID}); !errors.Is(err, apperrors.ErrAlreadyExists) {
+		t.Fatalf("second wallet of the same partner: error = %v, want ALREADY_EXISTS", err)
+	}
+	if _, err := create.Execute(ctx, &request.CreateWalletRequest{AccountID: accountID, ClientID: competitor.ID}); err != nil {
+		t.Fatalf("competitor's wallet with the same account ID: %v", err)
+	}
+
+	_, err := env.deposit.Execute(ctx, &request.DepositRequest{AccountID: accountID, Amount: 5000, ClientID: env.clientID})
+	if err != nil {
+		t.Fatalf("deposit: %v", err)
+	}
+
+	own, err := balance.Execute(ctx, &request.GetBalanceRequest{AccountID: accountID, ClientID: env.clientID})
+	if err != nil {
+		t.Fatalf("partner's balance: %v", err)
+	}
+	theirs, err := balance.Execute(ctx, &request.GetBalanceRequest{AccountID: accountID, ClientID: competitor.ID})
+	if err != nil {
+		t.Fatalf("competitor's balance: %v", err)
+	}
+	if own.Balance != 5000 || theirs.Balance != 0 {
+		t.Errorf("balances %d and %d, want the deposit only in the partner's wallet (5000 and 0)", own.Balance, theirs.Balance)
+	}
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// WalletStatusUseCase handles admin changes of a wallet's lifecycle status
+type WalletStatusUseCase struct {
+	db               *gorm.DB
+	clientRepo       repository.ClientRepository
+	walletRepo       repository.WalletRepository
+	statusChangeRepo repository.WalletStatusChangeRepository
+	webhooks         *WebhookPublisher
+}
+
+// NewWalletStatusUseCase creates a new WalletStatusUseCase
+func NewWalletStatusUseCase(
+	db *gorm.DB,
+	clientRepo repository.ClientRepository,
+	walletRepo repository.WalletRepository,
+	statusChangeRepo repository.WalletStatusChangeRepository,
+	webhooks *WebhookPublisher,
+) *WalletStatusUseCase {
+	return &WalletStatusUseCase{
+		db:               db,
+		clientRepo:       clientRepo,
+		walletRepo:       walletRepo,
+		statusChangeRepo: statusChangeRepo,
+		webhooks:         webhooks,
+	}
+}
+
+// Execute moves the wallet to the requested status and records the change with its reason
+func (uc *WalletStatusUseCase) Execute(ctx context.Context, req *request.ChangeWalletStatusRequest) (*response.ChangeWalletStatusResponse, error) {
+	// Validate request
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	accountID, err := valueobject.NewAccountID(req.AccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	status, err := valueobject.NewWalletStatus(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+
+	var resp *response.ChangeWalletStatusResponse
+	err = uc.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		// Lock the wallet so the zero-balance check for closing cannot race with a deposit
+		wallet, err := findAdminWallet(txCtx, uc.clientRepo, uc.walletRepo, req.UserID, accountID, true)
+		if err != nil {
+			return err
+		}
+
+		previousStatus := wallet.Status
+		if err := wallet.ChangeStatus(status); err != nil {
+			return err
+		}
+
+		if err := uc.walletRepo.Update(txCtx, wallet); err != nil {
+			return err
+		}
+
+		change := entity.NewWalletStatusChange(wallet.ID, previousStatus, wallet.Status, reason, req.ClientID)
+		if err := uc.statusChangeRepo.Create(txCtx, change); err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Wallet %s status changed from %s to %s by client_id %d: %s",
+			accountID.Value(), previousStatus, wallet.Status, req.ClientID, reason)
+
+		// Tell the owning partner; the event is delivered after this DB transaction commits
+		err = uc.webhooks.Publish(txCtx, wallet.OwnerClientID, entity.WebhookEventWalletStatusChange, &response.WalletStatusChangedEvent{
+			AccountID:      accountID.Value(),
+			PreviousStatus: previousStatus.String(),
+			Status:         wallet.Status.String(),
+			Reason:         reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		resp = &response.ChangeWalletStatusResponse{
+			Success:        true,
+			AccountID:      accountID.Value(),
+			PreviousStatus: previousStatus.String(),
+			Status:         wallet.Status.String(),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+)
+
+// WalletStatusHistoryUseCase handles retrieval of the wallet status audit trail
+type WalletStatusHistoryUseCase struct {
+	clientRepo       repository.ClientRepository
+	walletRepo       repository.WalletRepository
+	statusChangeRepo repository.WalletStatusChangeRepository
+}
+
+// NewWalletStatusHistoryUseCase creates a new WalletStatusHistoryUseCase
+func NewWalletStatusHistoryUseCase(
+	clientRepo repository.ClientRepository,
+	walletRepo repository.WalletRepository,
+	statusChangeRepo repository.WalletStatusChangeRepository,
+) *WalletStatusHistoryUseCase {
+	return &WalletStatusHistoryUseCase{
+		clientRepo:       clientRepo,
+		walletRepo:       walletRepo,
+		statusChangeRepo: statusChangeRepo,
+	}
+}
+
+// Execute returns every status change of the wallet, oldest first
+func (uc *WalletStatusHistoryUseCase) Execute(ctx context.Context, req *request.WalletStatusHistoryRequest) (*response.WalletStatusHistoryResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	accountID, err := valueobject.NewAccountID(req.AccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	wallet, err := findAdminWallet(ctx, uc.clientRepo, uc.walletRepo, req.UserID, accountID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := uc.statusChangeRepo.FindByWalletID(ctx, wallet.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.WalletStatusHistoryResponse{
+		AccountID: accountID.Value(),
+		Status:    wallet.Status.String(),
+		Changes:   make([]response.WalletStatusChangeItem, 0, len(changes)),
+	}
+
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, response.WalletStatusChangeItem{
+			FromStatus:    change.FromStatus.String(),
+			ToStatus:      change.ToStatus.String(),
+			Reason:        change.Reason,
+			ActorClientID: change.ActorClientID,
+			CreatedAt:     change.CreatedAt,
+		})
+	}
+
+	return resp, nil
+}
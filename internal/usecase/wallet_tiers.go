@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/response"
+)
+
+// WalletTiersUseCase lists the wallet tiers and their limits
+type WalletTiersUseCase struct{}
+
+// NewWalletTiersUseCase creates a new WalletTiersUseCase
+func NewWalletTiersUseCase() *WalletTiersUseCase {
+	return &WalletTiersUseCase{}
+}
+
+// Execute returns every registered tier, lowest max balance first
+func (uc *WalletTiersUseCase) Execute(_ context.Context) (*response.WalletTiersResponse, error) {
+	tiers := valueobject.WalletTiers()
+
+	resp := &response.WalletTiersResponse{
+		Currency: valueobject.CurrencyTJS,
+		Tiers:    make([]response.WalletTierItem, 0, len(tiers)),
+	}
+
+	for _, tier := range tiers {
+		resp.Tiers = append(resp.Tiers, response.WalletTierItem{
+			WalletType:    tier.Type.String(),
+			Name:          tier.Name,
+			MaxBalance:    tier.MaxBalance.Dirams(),
+			MaxOperation:  tier.Limits.MaxOperation,
+			DailyCount:    tier.Limits.DailyCount,
+			DailyAmount:   tier.Limits.DailyAmount,
+			MonthlyCount:  tier.Limits.MonthlyCount,
+			MonthlyAmount: tier.Limits.MonthlyAmount,
+		})
+	}
+
+	return resp, nil
+}
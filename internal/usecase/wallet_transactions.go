@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultTransactionsPageSize = 20
+
+// WalletTransactionsUseCase handles paginated transaction history retrieval
+type WalletTransactionsUseCase struct {
+	walletRepo      repository.WalletRepository
+	transactionRepo repository.TransactionRepository
+}
+
+// NewWalletTransactionsUseCase creates a new WalletTransactionsUseCase
+func NewWalletTransactionsUseCase(
+	walletRepo repository.WalletRepository,
+	transactionRepo repository.TransactionRepository,
+) *WalletTransactionsUseCase {
+	return &WalletTransactionsUseCase{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// Execute returns one page of the wallet's transactions, newest first
+func (uc *WalletTransactionsUseCase) Execute(ctx context.Context, req *request.GetTransactionsRequest) (*response.TransactionHistoryResponse, error) {
+	// Validate request
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, apperrors.ErrValidationFailed
+	}
+	if req.MinAmount != nil && req.MaxAmount != nil && *req.MinAmount > *req.MaxAmount {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	accountID, err := valueobject.NewAccountID(req.AccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	filter := repository.TransactionFilter{
+		From:      req.From,
+		To:        req.To,
+		MinAmount: req.MinAmount,
+		MaxAmount: req.MaxAmount,
+		Limit:     req.Limit,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultTransactionsPageSize
+	}
+
+	for _, t := range req.Types {
+		txType := entity.TransactionType(t)
+		if !txType.IsValid() {
+			return nil, apperrors.ErrInvalidTxType
+		}
+		filter.Types = append(filter.Types, txType)
+	}
+
+	if req.Cursor != "" {
+		createdAt, id, err := decodeTransactionCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.AfterCreatedAt = &createdAt
+		filter.AfterID = id
+	}
+
+	// Find wallet
+	wallet, err := uc.walletRepo.FindOwnedByAccountID(ctx, req.ClientID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wallet.EnsureAccessible(); err != nil {
+		return nil, err
+	}
+	filter.WalletID = wallet.ID
+
+	// Fetch one extra row to learn whether another page follows
+	pageSize := filter.Limit
+	filter.Limit++
+	transactions, err := uc.transactionRepo.FindPage(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.TransactionHistoryResponse{
+		AccountID:    accountID.Value(),
+		Currency:     valueobject.CurrencyTJS,
+		Transactions: make([]response.TransactionItem, 0, pageSize),
+	}
+
+	if len(transactions) > pageSize {
+		transactions = transactions[:pageSize]
+		last := transactions[pageSize-1]
+		resp.HasMore = true
+		resp.NextCursor = encodeTransactionCursor(last.CreatedAt, last.ID)
+	}
+
+	for _, tx := range transactions {
+		item := response.TransactionItem{
+			ID:          tx.ID,
+			Type:        string(tx.Type),
+			Amount:      tx.Amount.Dirams(),
+			Reference:   tx.Reference,
+			FeeRuleID:   tx.FeeRuleID,
+			ExternalID:  tx.ExternalID,
+			Description: tx.Description,
+			Metadata:    tx.Metadata,
+			Status:      string(tx.Status),
+			CreatedAt:   tx.CreatedAt,
+		}
+		if tx.BalanceAfter != nil {
+			balance := tx.BalanceAfter.Dirams()
+			item.BalanceAfter = &balance
+		}
+		resp.Transactions = append(resp.Transactions, item)
+	}
+
+	return resp, nil
+}
+
+// encodeTransactionCursor packs the position of the last returned row into an opaque token
+func encodeTransactionCursor(createdAt time.Time, id int64) string {
+	raw := fmt.Sprintf("%d:%d", createdAt.UnixNano(), id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeTransactionCursor(cursor string) (time.Time, int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, 0, apperrors.ErrInvalidCursor
+	}
+
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return time.Time{}, 0, apperrors.ErrInvalidCursor
+	}
+
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, 0, apperrors.ErrInvalidCursor
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || id <= 0 {
+		return time.Time{}, 0, apperrors.ErrInvalidCursor
+	}
+
+	return time.Unix(0, nanos), id, nil
+}
@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/service"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WalletTransferUseCase handles wallet-to-wallet transfers
+type WalletTransferUseCase struct {
+	db               *gorm.DB
+	walletRepo       repository.WalletRepository
+	transactionRepo  repository.TransactionRepository
+	balanceValidator *service.BalanceValidator
+	ledger           *LedgerUseCase
+	fees             *FeeUseCase
+	idempotency      *IdempotencyUseCase
+}
+
+// NewWalletTransferUseCase creates a new WalletTransferUseCase
+func NewWalletTransferUseCase(
+	db *gorm.DB,
+	walletRepo repository.WalletRepository,
+	transactionRepo repository.TransactionRepository,
+	balanceValidator *service.BalanceValidator,
+	ledger *LedgerUseCase,
+	fees *FeeUseCase,
+	idempotency *IdempotencyUseCase,
+) *WalletTransferUseCase {
+	return &WalletTransferUseCase{
+		db:               db,
+		walletRepo:       walletRepo,
+		transactionRepo:  transactionRepo,
+		balanceValidator: balanceValidator,
+		ledger:           ledger,
+		fees:             fees,
+		idempotency:      idempotency,
+	}
+}
+
+// Execute moves money from one wallet to another in a single DB transaction
+func (uc *WalletTransferUseCase) Execute(ctx context.Context, req *request.TransferRequest) (*response.TransferResponse, error) {
+	// Validate request
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	// Create value objects
+	fromAccountID, err := valueobject.NewAccountID(req.FromAccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	toAccountID, err := valueobject.NewAccountID(req.ToAccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	if fromAccountID.Equals(toAccountID) {
+		return nil, apperrors.ErrSameWalletTransfer
+	}
+
+	amount, err := valueobject.NewMoney(req.Amount)
+	if err != nil {
+		return nil, apperrors.ErrInvalidAmount
+	}
+
+	// Return the stored response if this is a retry of a completed request
+	if req.IdempotencyKey != "" {
+		var replayed response.TransferResponse
+		found, err := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationTransfer, req, &replayed)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return &replayed, nil
+		}
+	}
+
+	// Start transaction
+	var resp *response.TransferResponse
+	err = uc.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		source, destination, err := uc.lockWallets(txCtx, req.ClientID, fromAccountID, toAccountID)
+		if err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Transferring %d dirams from wallet %s (balance: %d) to wallet %s (balance: %d)",
+			amount.Dirams(), fromAccountID.Value(), source.Balance.Dirams(), toAccountID.Value(), destination.Balance.Dirams())
+
+		// The fee is the partner's commission, drawn from its float; the source wallet only pays the amount
+		quote, err := uc.fees.Quote(txCtx, req.ClientID, entity.FeeOperationTransfer, source, amount)
+		if err != nil {
+			return err
+		}
+
+		// Validate both legs before touching balances
+		if err := uc.balanceValidator.ValidateWithdrawal(source, amount); err != nil {
+			return err
+		}
+		if err := uc.balanceValidator.ValidateDeposit(txCtx, destination, amount); err != nil {
+			return err
+		}
+
+		// Open the ledger accounts before the balances change
+		sourceAccount, err := uc.ledger.WalletAccount(txCtx, source)
+		if err != nil {
+			return err
+		}
+		destinationAccount, err := uc.ledger.WalletAccount(txCtx, destination)
+		if err != nil {
+			return err
+		}
+
+		if err := source.Withdraw(amount); err != nil {
+			return err
+		}
+		if err := destination.Deposit(amount); err != nil {
+			return err
+		}
+
+		if err := uc.walletRepo.Update(txCtx, source); err != nil {
+			return err
+		}
+		if err := uc.walletRepo.Update(txCtx, destination); err != nil {
+			return err
+		}
+
+		// Both legs share one reference so they can be matched later
+		reference := uuid.New().String()
+
+		outTransaction := entity.NewTransaction(source.ID, req.ClientID, entity.TransactionTypeTransferOut, amount, source.Balance)
+		outTransaction.Reference = reference
+		if err := uc.transactionRepo.Create(txCtx, outTransaction); err != nil {
+			return err
+		}
+
+		inTransaction := entity.NewTransaction(destination.ID, req.ClientID, entity.TransactionTypeTransferIn, amount, destination.Balance)
+		inTransaction.Reference = reference
+		if err := uc.transactionRepo.Create(txCtx, inTransaction); err != nil {
+			return err
+		}
+
+		if err := uc.ledger.Move(txCtx, entity.JournalEntryKindTransfer, reference, sourceAccount, destinationAccount, amount); err != nil {
+			return err
+		}
+		feeTransaction, err := uc.fees.Charge(txCtx, source, req.ClientID, reference, quote)
+		if err != nil {
+			return err
+		}
+		if err := uc.ledger.VerifyWallet(txCtx, source, sourceAccount.ID); err != nil {
+			return err
+		}
+		if err := uc.ledger.VerifyWallet(txCtx, destination, destinationAccount.ID); err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Transfer successful. Reference: %s, source balance: %d dirams, destination balance: %d dirams",
+			reference, source.Balance.Dirams(), destination.Balance.Dirams())
+
+		// Build response
+		resp = &response.TransferResponse{
+			Success:          true,
+			FromAccountID:    fromAccountID.Value(),
+			ToAccountID:      toAccountID.Value(),
+			Amount:           amount.Dirams(),
+			Fee:              quote.Fee.Dirams(),
+			NetAmount:        amount.Dirams() - quote.Fee.Dirams(),
+			NewBalance:       source.Balance.Dirams(),
+			Currency:         valueobject.CurrencyTJS,
+			Reference:        reference,
+			OutTransactionID: outTransaction.ID,
+			InTransactionID:  inTransaction.ID,
+		}
+		if feeTransaction != nil {
+			resp.FeeTransactionID = &feeTransaction.ID
+		}
+
+		// Store the response in the same DB transaction as the operation
+		if req.IdempotencyKey != "" {
+			if err := uc.idempotency.Save(txCtx, req.ClientID, req.IdempotencyKey, OperationTransfer, req, resp); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		// A concurrent retry with the same key committed first; replay its response
+		if req.IdempotencyKey != "" && errors.Is(err, apperrors.ErrAlreadyExists) {
+			var replayed response.TransferResponse
+			found, replayErr := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationTransfer, req, &replayed)
+			if replayErr != nil {
+				return nil, replayErr
+			}
+			if found {
+				return &replayed, nil
+			}
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// lockWallets locks both wallet rows in ascending ID order so that concurrent
+// transfers in opposite directions cannot deadlock. Both wallets must belong to the client.
+func (uc *WalletTransferUseCase) lockWallets(ctx context.Context, clientID int64, fromAccountID, toAccountID valueobject.AccountID) (*entity.Wallet, *entity.Wallet, error) {
+	source, err := uc.walletRepo.FindOwnedByAccountID(ctx, clientID, fromAccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	destination, err := uc.walletRepo.FindOwnedByAccountID(ctx, clientID, toAccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	first, second := source.ID, destination.ID
+	if first > second {
+		first, second = second, first
+	}
+
+	firstWallet, err := uc.walletRepo.FindByIDForUpdate(ctx, first)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secondWallet, err := uc.walletRepo.FindByIDForUpdate(ctx, second)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if firstWallet.ID == source.ID {
+		return firstWallet, secondWallet, nil
+	}
+	return secondWallet, firstWallet, nil
+}
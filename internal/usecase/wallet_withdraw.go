@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/domain/service"
+	"e-wallet/internal/domain/valueobject"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/database"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WalletWithdrawUseCase handles wallet withdrawal operations
+type WalletWithdrawUseCase struct {
+	db               *gorm.DB
+	walletRepo       repository.WalletRepository
+	transactionRepo  repository.TransactionRepository
+	balanceValidator *service.BalanceValidator
+	ledger           *LedgerUseCase
+	fees             *FeeUseCase
+	idempotency      *IdempotencyUseCase
+}
+
+// NewWalletWithdrawUseCase creates a new WalletWithdrawUseCase
+func NewWalletWithdrawUseCase(
+	db *gorm.DB,
+	walletRepo repository.WalletRepository,
+	transactionRepo repository.TransactionRepository,
+	balanceValidator *service.BalanceValidator,
+	ledger *LedgerUseCase,
+	fees *FeeUseCase,
+	idempotency *IdempotencyUseCase,
+) *WalletWithdrawUseCase {
+	return &WalletWithdrawUseCase{
+		db:               db,
+		walletRepo:       walletRepo,
+		transactionRepo:  transactionRepo,
+		balanceValidator: balanceValidator,
+		ledger:           ledger,
+		fees:             fees,
+		idempotency:      idempotency,
+	}
+}
+
+// Execute performs a withdrawal operation
+func (uc *WalletWithdrawUseCase) Execute(ctx context.Context, req *request.WithdrawRequest) (*response.WithdrawResponse, error) {
+	// Validate request
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	// Create value objects
+	accountID, err := valueobject.NewAccountID(req.AccountID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRequest
+	}
+
+	amount, err := valueobject.NewMoney(req.Amount)
+	if err != nil {
+		return nil, apperrors.ErrInvalidAmount
+	}
+
+	// Return the stored response if this is a retry of a completed request
+	if req.IdempotencyKey != "" {
+		var replayed response.WithdrawResponse
+		found, err := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationWithdraw, req, &replayed)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return &replayed, nil
+		}
+	}
+
+	// Start transaction
+	var resp *response.WithdrawResponse
+	err = uc.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := database.InjectTx(ctx, tx)
+
+		// Lock the wallet row so concurrent operations cannot overwrite each other's balance
+		wallet, err := uc.walletRepo.FindOwnedByAccountIDForUpdate(txCtx, req.ClientID, accountID)
+		if err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Withdrawing %d dirams from wallet %s (current balance: %d dirams)",
+			amount.Dirams(), accountID.Value(), wallet.Balance.Dirams())
+
+		// The fee is the partner's commission, drawn from its float; the wallet only pays the amount
+		quote, err := uc.fees.Quote(txCtx, req.ClientID, entity.FeeOperationWithdrawal, wallet, amount)
+		if err != nil {
+			return err
+		}
+
+		// Validate withdrawal
+		if err := uc.balanceValidator.ValidateWithdrawal(wallet, amount); err != nil {
+			return err
+		}
+
+		// Open the ledger accounts before the balance changes
+		walletAccount, err := uc.ledger.WalletAccount(txCtx, wallet)
+		if err != nil {
+			return err
+		}
+		floatAccount, err := uc.ledger.PartnerFloatAccount(txCtx, req.ClientID)
+		if err != nil {
+			return err
+		}
+
+		// Perform withdrawal
+		if err := wallet.Withdraw(amount); err != nil {
+			return err
+		}
+
+		// Update wallet
+		if err := uc.walletRepo.Update(txCtx, wallet); err != nil {
+			return err
+		}
+
+		// Create transaction record
+		transaction := entity.NewTransaction(wallet.ID, req.ClientID, entity.TransactionTypeWithdrawal, amount, wallet.Balance)
+		transaction.Reference = uuid.New().String()
+		if err := uc.transactionRepo.Create(txCtx, transaction); err != nil {
+			return err
+		}
+
+		// Money leaves the wallet back to the partner's float
+		if err := uc.ledger.Move(txCtx, entity.JournalEntryKindWithdrawal, transaction.Reference, walletAccount, floatAccount, amount); err != nil {
+			return err
+		}
+		feeTransaction, err := uc.fees.Charge(txCtx, wallet, req.ClientID, transaction.Reference, quote)
+		if err != nil {
+			return err
+		}
+		if err := uc.ledger.VerifyWallet(txCtx, wallet, walletAccount.ID); err != nil {
+			return err
+		}
+
+		logger.Info.Printf("Withdrawal successful. New balance: %d dirams, Transaction ID: %d",
+			wallet.Balance.Dirams(), transaction.ID)
+
+		// Build response
+		resp = &response.WithdrawResponse{
+			Success:       true,
+			AccountID:     accountID.Value(),
+			Amount:        amount.Dirams(),
+			Fee:           quote.Fee.Dirams(),
+			NetAmount:     amount.Dirams() - quote.Fee.Dirams(),
+			NewBalance:    wallet.Balance.Dirams(),
+			Currency:      valueobject.CurrencyTJS,
+			TransactionID: transaction.ID,
+		}
+		if feeTransaction != nil {
+			resp.FeeTransactionID = &feeTransaction.ID
+		}
+
+		// Store the response in the same DB transaction as the operation
+		if req.IdempotencyKey != "" {
+			if err := uc.idempotency.Save(txCtx, req.ClientID, req.IdempotencyKey, OperationWithdraw, req, resp); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		// A concurrent retry with the same key committed first; replay its response
+		if req.IdempotencyKey != "" && errors.Is(err, apperrors.ErrAlreadyExists) {
+			var replayed response.WithdrawResponse
+			found, replayErr := uc.idempotency.Replay(ctx, req.ClientID, req.IdempotencyKey, OperationWithdraw, req, &replayed)
+			if replayErr != nil {
+				return nil, replayErr
+			}
+			if found {
+				return &replayed, nil
+			}
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
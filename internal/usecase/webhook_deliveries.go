@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+)
+
+// defaultWebhookDeliveriesLimit is the page size when the request does not set one
+const defaultWebhookDeliveriesLimit = 20
+
+// WebhookDeliveriesUseCase lists the calling client's latest webhook deliveries
+type WebhookDeliveriesUseCase struct {
+	outboxRepo repository.WebhookOutboxRepository
+}
+
+// NewWebhookDeliveriesUseCase creates a new WebhookDeliveriesUseCase
+func NewWebhookDeliveriesUseCase(outboxRepo repository.WebhookOutboxRepository) *WebhookDeliveriesUseCase {
+	return &WebhookDeliveriesUseCase{
+		outboxRepo: outboxRepo,
+	}
+}
+
+// Execute returns the latest deliveries, optionally only those with the given status
+func (uc *WebhookDeliveriesUseCase) Execute(ctx context.Context, req *request.ListWebhookDeliveriesRequest) (*response.WebhookDeliveriesResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultWebhookDeliveriesLimit
+	}
+
+	deliveries, err := uc.outboxRepo.FindByClient(ctx, req.ClientID, entity.WebhookDeliveryStatus(req.Status), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]response.WebhookDeliveryItem, len(deliveries))
+	for i, delivery := range deliveries {
+		items[i] = newWebhookDeliveryItem(delivery)
+	}
+
+	return &response.WebhookDeliveriesResponse{Deliveries: items}, nil
+}
+
+func newWebhookDeliveryItem(delivery *entity.WebhookDelivery) response.WebhookDeliveryItem {
+	return response.WebhookDeliveryItem{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/infrastructure/logger"
+	"e-wallet/pkg/crypto"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxWebhookErrorLength bounds the stored error of a failed attempt (webhook_outbox.last_error)
+const maxWebhookErrorLength = 500
+
+// WebhookDispatcher delivers pending outbox rows to subscriber endpoints
+type WebhookDispatcher struct {
+	outboxRepo       repository.WebhookOutboxRepository
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	clientRepo       repository.ClientRepository
+	httpClient       *http.Client
+	algorithm        crypto.HMACAlgorithm
+	policy           entity.WebhookRetryPolicy
+	interval         time.Duration
+	batchSize        int
+}
+
+// NewWebhookDispatcher creates a new WebhookDispatcher
+func NewWebhookDispatcher(
+	outboxRepo repository.WebhookOutboxRepository,
+	subscriptionRepo repository.WebhookSubscriptionRepository,
+	clientRepo repository.ClientRepository,
+	algorithm crypto.HMACAlgorithm,
+	policy entity.WebhookRetryPolicy,
+	interval, timeout time.Duration,
+	batchSize int,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		outboxRepo:       outboxRepo,
+		subscriptionRepo: subscriptionRepo,
+		clientRepo:       clientRepo,
+		httpClient:       newWebhookHTTPClient(timeout),
+		algorithm:        algorithm,
+		policy:           policy,
+		interval:         interval,
+		batchSize:        batchSize,
+	}
+}
+
+// Run dispatches every interval until ctx is cancelled
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := d.Dispatch(ctx); err != nil {
+				logger.Error.Printf("[usecase.WebhookDispatcher]: Dispatch failed: %v", err)
+			}
+		}
+	}
+}
+
+// Dispatch sends one batch of due deliveries concurrently and returns how many were attempted.
+// Delivery is at least once: a delivery whose result could not be stored is sent again after its lease.
+func (d *WebhookDispatcher) Dispatch(ctx context.Context) (int, error) {
+	// The lease outlives an attempt, so another worker only retries deliveries of a crashed one
+	lease := 2 * d.httpClient.Timeout
+	deliveries, err := d.outboxRepo.ClaimDue(ctx, time.Now(), lease, d.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *entity.WebhookDelivery) {
+			defer wg.Done()
+			d.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+func (d *WebhookDispatcher) deliver(ctx context.Context, delivery *entity.WebhookDelivery) {
+	subscription, err := d.subscriptionRepo.FindByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		logger.Error.Printf("[usecase.WebhookDispatcher]: Failed to load subscription %d of delivery %d: %v", delivery.SubscriptionID, delivery.ID, err)
+		return
+	}
+
+	if !subscription.IsActive {
+		delivery.MarkDead("subscription removed", time.Now())
+	} else if statusCode, err := d.send(ctx, subscription, delivery); err != nil {
+		delivery.MarkFailed(truncate(err.Error(), maxWebhookErrorLength), statusCode, time.Now(), d.policy)
+		logger.Warning.Printf("[usecase.WebhookDispatcher]: Delivery %d (%s) to %s failed, attempt %d: %v",
+			delivery.ID, delivery.EventType, subscription.URL, delivery.Attempts, err)
+	} else {
+		delivery.MarkDelivered(statusCode, time.Now())
+	}
+
+	if delivery.Status == entity.WebhookDeliveryDead {
+		logger.Warning.Printf("[usecase.WebhookDispatcher]: Delivery %d (event %s) moved to dead letter: %s",
+			delivery.ID, delivery.EventID, delivery.LastError)
+	}
+
+	if err := d.outboxRepo.Update(ctx, delivery); err != nil {
+		logger.Error.Printf("[usecase.WebhookDispatcher]: Failed to store result of delivery %d: %v", delivery.ID, err)
+	}
+}
+
+// send posts the payload signed with the client's secret and returns the HTTP status.
+// Any status outside 2xx is a failure.
+func (d *WebhookDispatcher) send(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (int, error) {
+	client, err := d.clientRepo.FindByID(ctx, delivery.ClientID)
+	if err != nil {
+		return 0, fmt.Errorf("client lookup failed: %w", err)
+	}
+
+	// Subscriptions made before only https was accepted may still point elsewhere
+	if err := validateWebhookURL(subscription.URL); err != nil {
+		return 0, fmt.Errorf("endpoint refused: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature := crypto.ComputeHMAC(d.algorithm, client.SecretKey, crypto.BuildWebhookSigningString(timestamp, delivery.Payload))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", delivery.EventID)
+	req.Header.Set("X-Webhook-Event", string(delivery.EventType))
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", signature)
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with HTTP %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func truncate(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+	// Cutting may split a multi-byte character; drop the partial one
+	return strings.ToValidUTF8(s[:maxLength], "")
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// carrierGradeNAT is the shared address space of RFC 6598, internal to the provider like RFC 1918 ranges
+var carrierGradeNAT = netip.MustParsePrefix("100.64.0.0/10")
+
+// isPublicWebhookIP reports whether a webhook may be sent to ip. Loopback, private, link-local
+// (which includes the 169.254.169.254 metadata endpoint), unspecified and multicast addresses
+// belong to the server's own network and are refused.
+func isPublicWebhookIP(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() &&
+		!ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified() &&
+		!carrierGradeNAT.Contains(ip)
+}
+
+// validateWebhookURL accepts only https URLs whose host is a name or a public IP.
+// Names are resolved at delivery time, where the dialer checks the address they resolve to.
+func validateWebhookURL(rawURL string) error {
+	endpoint, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if endpoint.Scheme != "https" {
+		return fmt.Errorf("scheme %q is not https", endpoint.Scheme)
+	}
+
+	host := endpoint.Hostname()
+	if host == "" {
+		return fmt.Errorf("host is missing")
+	}
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return fmt.Errorf("host %s is not public", host)
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && !isPublicWebhookIP(ip) {
+		return fmt.Errorf("address %s is not public", ip)
+	}
+
+	return nil
+}
+
+// newWebhookHTTPClient returns the client deliveries are sent with. Every connection is checked
+// after DNS resolution, so a name that resolves to an internal address is refused too, and
+// redirects are not followed: a 3xx response is returned as is and counts as a failed attempt.
+func newWebhookHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !isPublicWebhookIP(addrPort.Addr()) {
+				return fmt.Errorf("webhook endpoint address %s is not public", addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		// No Proxy: a proxy would make the dial check apply to the proxy instead of the endpoint
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{url: "https://partner.example/hooks/wallet"},
+		{url: "https://8.8.8.8/hooks"},
+		{url: "https://[2001:4860:4860::8888]/hooks"},
+		{url: "http://partner.example/hooks/wallet", wantErr: true},
+		{url: "ftp://partner.example/hooks", wantErr: true},
+		{url: "https:///hooks", wantErr: true},
+		{url: "https://localhost/hooks", wantErr: true},
+		{url: "https://api.localhost/hooks", wantErr: true},
+		{url: "https://127.0.0.1/hooks", wantErr: true},
+		{url: "https://[::1]/hooks", wantErr: true},
+		{url: "https://10.0.0.5/hooks", wantErr: true},
+		{url: "https://172.16.3.4/hooks", wantErr: true},
+		{url: "https://192.168.1.10:8443/hooks", wantErr: true},
+		{url: "https://169.254.169.254/latest/meta-data", wantErr: true},
+		{url: "https://100.64.0.1/hooks", wantErr: true},
+		{url: "https://0.0.0.0/hooks", wantErr: true},
+		{url: "https://[fd00::1]/hooks", wantErr: true},
+		{url: "https://[fe80::1]/hooks", wantErr: true},
+		{url: "https://[::ffff:127.0.0.1]/hooks", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := validateWebhookURL(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateWebhookURL(%q) error = %v, want error %v", tt.url, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookHTTPClientRefusesInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newWebhookHTTPClient(2 * time.Second)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.Do(req)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("request to a loopback server succeeded, want it refused at dial time")
+	}
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) || opErr.Op != "dial" {
+		t.Errorf("error = %v, want a dial error", err)
+	}
+}
+
+func TestWebhookHTTPClientDoesNotFollowRedirects(t *testing.T) {
+	client := newWebhookHTTPClient(2 * time.Second)
+	via := []*http.Request{{}}
+	if err := client.CheckRedirect(&http.Request{}, via); !errors.Is(err, http.ErrUseLastResponse) {
+		t.Errorf("CheckRedirect = %v, want http.ErrUseLastResponse", err)
+	}
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/infrastructure/logger"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookPublisher writes events to the webhook outbox
+type WebhookPublisher struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	outboxRepo       repository.WebhookOutboxRepository
+}
+
+// NewWebhookPublisher creates a new WebhookPublisher
+func NewWebhookPublisher(subscriptionRepo repository.WebhookSubscriptionRepository, outboxRepo repository.WebhookOutboxRepository) *WebhookPublisher {
+	return &WebhookPublisher{
+		subscriptionRepo: subscriptionRepo,
+		outboxRepo:       outboxRepo,
+	}
+}
+
+// Subscribed reports whether the client has an active subscription for the event type,
+// so callers can skip building events nobody receives
+func (p *WebhookPublisher) Subscribed(ctx context.Context, clientID int64, eventType entity.WebhookEventType) (bool, error) {
+	if clientID == 0 {
+		return false, nil
+	}
+
+	subscriptions, err := p.subscriptionRepo.FindByClient(ctx, clientID, true)
+	if err != nil {
+		return false, err
+	}
+	for _, subscription := range subscriptions {
+		if subscription.Wants(eventType) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Publish writes the event to the outbox once for every subscription of the client that wants it.
+// ctx must carry the DB transaction of the change the event describes, so the event is stored
+// if and only if that change commits.
+func (p *WebhookPublisher) Publish(ctx context.Context, clientID int64, eventType entity.WebhookEventType, data any) error {
+	if clientID == 0 {
+		return nil
+	}
+
+	subscriptions, err := p.subscriptionRepo.FindByClient(ctx, clientID, true)
+	if err != nil {
+		return err
+	}
+
+	var event *entity.WebhookEvent
+	var payload []byte
+	for _, subscription := range subscriptions {
+		if !subscription.Wants(eventType) {
+			continue
+		}
+
+		if event == nil {
+			event = &entity.WebhookEvent{
+				ID:        uuid.New().String(),
+				Type:      eventType,
+				CreatedAt: time.Now(),
+				Data:      data,
+			}
+			payload, err = json.Marshal(event)
+			if err != nil {
+				return fmt.Errorf("[usecase.WebhookPublisher]: failed to encode %s event: %w", eventType, err)
+			}
+		}
+
+		if err := p.outboxRepo.Create(ctx, entity.NewWebhookDelivery(subscription, event, string(payload))); err != nil {
+			return err
+		}
+	}
+
+	if event != nil {
+		logger.Info.Printf("Webhook event %s (%s) queued for client_id %d", event.ID, eventType, clientID)
+	}
+
+	return nil
+}
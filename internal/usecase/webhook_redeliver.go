@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"time"
+)
+
+// WebhookRedeliverUseCase queues a delivered or dead webhook delivery again
+type WebhookRedeliverUseCase struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	outboxRepo       repository.WebhookOutboxRepository
+}
+
+// NewWebhookRedeliverUseCase creates a new WebhookRedeliverUseCase
+func NewWebhookRedeliverUseCase(subscriptionRepo repository.WebhookSubscriptionRepository, outboxRepo repository.WebhookOutboxRepository) *WebhookRedeliverUseCase {
+	return &WebhookRedeliverUseCase{
+		subscriptionRepo: subscriptionRepo,
+		outboxRepo:       outboxRepo,
+	}
+}
+
+// Execute resets the delivery to pending with a fresh set of attempts; the dispatcher sends it on its next run.
+// The same event ID and payload are sent again.
+func (uc *WebhookRedeliverUseCase) Execute(ctx context.Context, req *request.RedeliverWebhookRequest) (*response.RedeliverWebhookResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	delivery, err := uc.outboxRepo.FindByID(ctx, req.DeliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.ClientID != req.ClientID {
+		return nil, apperrors.ErrDeliveryNotFound
+	}
+
+	subscription, err := uc.subscriptionRepo.FindByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if !subscription.IsActive {
+		return nil, apperrors.ErrSubscriptionNotFound
+	}
+
+	if err := delivery.Redeliver(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.outboxRepo.Update(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	logger.Info.Printf("Webhook delivery %d (event %s) queued again by client_id %d", delivery.ID, delivery.EventID, req.ClientID)
+
+	return &response.RedeliverWebhookResponse{
+		Success:  true,
+		Delivery: newWebhookDeliveryItem(delivery),
+	}, nil
+}
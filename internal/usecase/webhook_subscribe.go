@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+)
+
+// WebhookSubscribeUseCase registers an endpoint of the calling client for webhook events
+type WebhookSubscribeUseCase struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+}
+
+// NewWebhookSubscribeUseCase creates a new WebhookSubscribeUseCase
+func NewWebhookSubscribeUseCase(subscriptionRepo repository.WebhookSubscriptionRepository) *WebhookSubscribeUseCase {
+	return &WebhookSubscribeUseCase{
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+// Execute creates the subscription; only https URLs of public hosts are accepted
+func (uc *WebhookSubscribeUseCase) Execute(ctx context.Context, req *request.CreateWebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	if err := validateWebhookURL(req.URL); err != nil {
+		logger.Warning.Printf("[usecase.WebhookSubscribeUseCase]: Client %d tried to subscribe %s: %v", req.ClientID, req.URL, err)
+		return nil, apperrors.ErrInvalidWebhookURL
+	}
+
+	eventTypes := make([]entity.WebhookEventType, 0, len(req.EventTypes))
+	for _, name := range req.EventTypes {
+		eventType := entity.WebhookEventType(name)
+		if !eventType.IsValid() {
+			return nil, apperrors.ErrInvalidEventType
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	subscription := entity.NewWebhookSubscription(req.ClientID, req.URL, eventTypes)
+	if err := uc.subscriptionRepo.Create(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	logger.Info.Printf("Webhook subscription %d created for client_id %d: %s", subscription.ID, req.ClientID, req.URL)
+
+	return &response.WebhookSubscriptionResponse{
+		Success:      true,
+		Subscription: newWebhookSubscriptionItem(subscription),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/entity"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+)
+
+// WebhookSubscriptionsUseCase lists the calling client's active webhook subscriptions
+type WebhookSubscriptionsUseCase struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+}
+
+// NewWebhookSubscriptionsUseCase creates a new WebhookSubscriptionsUseCase
+func NewWebhookSubscriptionsUseCase(subscriptionRepo repository.WebhookSubscriptionRepository) *WebhookSubscriptionsUseCase {
+	return &WebhookSubscriptionsUseCase{
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+// Execute returns the active subscriptions, oldest first
+func (uc *WebhookSubscriptionsUseCase) Execute(ctx context.Context, req *request.ListWebhookSubscriptionsRequest) (*response.WebhookSubscriptionsResponse, error) {
+	subscriptions, err := uc.subscriptionRepo.FindByClient(ctx, req.ClientID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]response.WebhookSubscriptionItem, len(subscriptions))
+	for i, subscription := range subscriptions {
+		items[i] = newWebhookSubscriptionItem(subscription)
+	}
+
+	return &response.WebhookSubscriptionsResponse{Subscriptions: items}, nil
+}
+
+func newWebhookSubscriptionItem(subscription *entity.WebhookSubscription) response.WebhookSubscriptionItem {
+	eventTypes := make([]string, len(subscription.EventTypes))
+	for i, eventType := range subscription.EventTypes {
+		eventTypes[i] = string(eventType)
+	}
+
+	return response.WebhookSubscriptionItem{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: eventTypes,
+		IsActive:   subscription.IsActive,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"e-wallet/internal/domain/repository"
+	"e-wallet/internal/dto/request"
+	"e-wallet/internal/dto/response"
+	"e-wallet/internal/infrastructure/logger"
+	apperrors "e-wallet/pkg/errors"
+	"e-wallet/pkg/validator"
+	"time"
+)
+
+// WebhookUnsubscribeUseCase removes a webhook subscription of the calling client
+type WebhookUnsubscribeUseCase struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+}
+
+// NewWebhookUnsubscribeUseCase creates a new WebhookUnsubscribeUseCase
+func NewWebhookUnsubscribeUseCase(subscriptionRepo repository.WebhookSubscriptionRepository) *WebhookUnsubscribeUseCase {
+	return &WebhookUnsubscribeUseCase{
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+// Execute deactivates the subscription. It is kept for the delivery history;
+// its pending deliveries are moved to the dead-letter state by the dispatcher.
+func (uc *WebhookUnsubscribeUseCase) Execute(ctx context.Context, req *request.DeleteWebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, apperrors.ErrValidationFailed
+	}
+
+	subscription, err := uc.subscriptionRepo.FindByID(ctx, req.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.ClientID != req.ClientID || !subscription.IsActive {
+		return nil, apperrors.ErrSubscriptionNotFound
+	}
+
+	subscription.IsActive = false
+	subscription.UpdatedAt = time.Now()
+	if err := uc.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	logger.Info.Printf("Webhook subscription %d removed by client_id %d", subscription.ID, req.ClientID)
+
+	return &response.WebhookSubscriptionResponse{
+		Success:      true,
+		Subscription: newWebhookSubscriptionItem(subscription),
+	}, nil
+}
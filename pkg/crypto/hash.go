@@ -0,0 +1,12 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// ComputeSHA256 returns the hex-encoded SHA-256 hash of data
+func ComputeSHA256(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
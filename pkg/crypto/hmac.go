@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"hash"
+	"strings"
 )
 
 type HMACAlgorithm string
@@ -32,8 +33,19 @@ func ComputeHMAC(algorithm HMACAlgorithm, secret, data string) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
+// BuildSigningString builds the string signed by replay-protected clients:
+// method, path, timestamp, nonce and body joined by newlines
+func BuildSigningString(method, path, timestamp, nonce, body string) string {
+	return strings.Join([]string{method, path, timestamp, nonce, body}, "\n")
+}
+
 // ValidateHMAC validates if the provided digest matches the computed HMAC
 func ValidateHMAC(algorithm HMACAlgorithm, secret, data, providedDigest string) bool {
 	expectedDigest := ComputeHMAC(algorithm, secret, data)
 	return hmac.Equal([]byte(expectedDigest), []byte(providedDigest))
 }
+
+// BuildWebhookSigningString builds the string signed for outgoing webhooks: timestamp and body joined by a newline
+func BuildWebhookSigningString(timestamp, body string) string {
+	return timestamp + "\n" + body
+}
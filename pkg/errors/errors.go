@@ -17,6 +17,11 @@ func (e *APIError) Error() string {
 
 var (
 	ErrBalanceExceedsLimit   = &APIError{"BALANCE_LIMIT_EXCEEDED", "Deposit would exceed wallet balance limit", http.StatusBadRequest}
+	ErrOperationLimit        = &APIError{"OPERATION_LIMIT_EXCEEDED", "Amount exceeds the single operation limit", http.StatusBadRequest}
+	ErrDailyCountLimit       = &APIError{"DAILY_COUNT_LIMIT_EXCEEDED", "Daily number of incoming operations exceeded", http.StatusBadRequest}
+	ErrDailyAmountLimit      = &APIError{"DAILY_AMOUNT_LIMIT_EXCEEDED", "Daily incoming turnover limit exceeded", http.StatusBadRequest}
+	ErrMonthlyCountLimit     = &APIError{"MONTHLY_COUNT_LIMIT_EXCEEDED", "Monthly number of incoming operations exceeded", http.StatusBadRequest}
+	ErrMonthlyAmountLimit    = &APIError{"MONTHLY_AMOUNT_LIMIT_EXCEEDED", "Monthly incoming turnover limit exceeded", http.StatusBadRequest}
 	ErrInvalidAmount         = &APIError{"INVALID_AMOUNT", "Invalid amount", http.StatusBadRequest}
 	ErrInvalidSignature      = &APIError{"INVALID_SIGNATURE", "Invalid HMAC signature", http.StatusUnauthorized}
 	ErrMissingAuthData       = &APIError{"MISSING_AUTH_DATA", "Missing authentication headers", http.StatusUnauthorized}
@@ -33,10 +38,55 @@ var (
 	ErrAlreadyExists         = &APIError{"ALREADY_EXISTS", "Resource already exists", http.StatusConflict}
 	ErrRecordNotFound        = &APIError{"RECORD_NOT_FOUND", "Record not found", http.StatusNotFound}
 	ErrWalletNotFound        = &APIError{"WALLET_NOT_FOUND", "Wallet not found", http.StatusNotFound}
+	ErrWalletAmbiguous       = &APIError{"WALLET_AMBIGUOUS", "Several partners hold a wallet with this account ID; pass user_id", http.StatusConflict}
 	ErrEmptyAccountID        = &APIError{"EMPTY_ACCOUNT_ID", "Account ID cannot be empty", http.StatusBadRequest}
 	ErrInvalidAccountID      = &APIError{"INVALID_ACCOUNT_ID", "Invalid account ID format", http.StatusBadRequest}
 	ErrInsufficientFunds     = &APIError{"INSUFFICIENT_FUNDS", "Insufficient funds in wallet", http.StatusBadRequest}
 	ErrInvalidWalletType     = &APIError{"INVALID_WALLET_TYPE", "Invalid wallet type", http.StatusBadRequest}
+	ErrSameWalletTransfer    = &APIError{"SAME_WALLET_TRANSFER", "Source and destination wallets must differ", http.StatusBadRequest}
+	ErrIdempotencyKeyReused  = &APIError{"IDEMPOTENCY_KEY_REUSED", "Idempotency key was already used with a different request", http.StatusUnprocessableEntity}
+	ErrIdempotencyKeyMissing = &APIError{"IDEMPOTENCY_KEY_REQUIRED", "Idempotency-Key header is required for this operation", http.StatusBadRequest}
+	ErrInvalidTimestamp      = &APIError{"INVALID_TIMESTAMP", "X-Timestamp must be a Unix time in seconds", http.StatusUnauthorized}
+	ErrTimestampOutOfWindow  = &APIError{"TIMESTAMP_OUT_OF_WINDOW", "Request timestamp is outside the allowed window", http.StatusUnauthorized}
+	ErrInvalidNonce          = &APIError{"INVALID_NONCE", "X-Nonce must be 8 to 128 characters", http.StatusUnauthorized}
+	ErrNonceReused           = &APIError{"NONCE_REUSED", "Request nonce was already used", http.StatusUnauthorized}
+	ErrInvalidCursor         = &APIError{"INVALID_CURSOR", "Pagination cursor is invalid", http.StatusBadRequest}
+	ErrAlreadyIdentified     = &APIError{"WALLET_ALREADY_IDENTIFIED", "Wallet is already identified", http.StatusConflict}
+	ErrIdentificationPending = &APIError{"IDENTIFICATION_PENDING", "Wallet identification is already pending review", http.StatusConflict}
+	ErrIdentificationNeeded  = &APIError{"IDENTIFICATION_REQUIRED", "Only unidentified wallets can be opened; higher tiers require approved identification", http.StatusBadRequest}
+	ErrNoPendingReview       = &APIError{"NO_PENDING_IDENTIFICATION", "Wallet has no identification pending review", http.StatusConflict}
+	ErrWalletFrozen          = &APIError{"WALLET_FROZEN", "Wallet is frozen; only credits are allowed", http.StatusForbidden}
+	ErrWalletBlocked         = &APIError{"WALLET_BLOCKED", "Wallet is blocked", http.StatusForbidden}
+	ErrWalletClosed          = &APIError{"WALLET_CLOSED", "Wallet is closed", http.StatusForbidden}
+	ErrInvalidWalletStatus   = &APIError{"INVALID_WALLET_STATUS", "Invalid wallet status", http.StatusBadRequest}
+	ErrInvalidStatusChange   = &APIError{"INVALID_STATUS_TRANSITION", "Wallet cannot move to the requested status", http.StatusConflict}
+	ErrBalanceNotZero        = &APIError{"BALANCE_NOT_ZERO", "Wallet balance must be zero to close it", http.StatusConflict}
+	ErrUnbalancedEntry       = &APIError{"LEDGER_UNBALANCED", "Journal entry debits and credits do not match", http.StatusInternalServerError}
+	ErrLedgerMismatch        = &APIError{"LEDGER_MISMATCH", "Wallet balance does not match the ledger", http.StatusInternalServerError}
+	ErrForbidden             = &APIError{"FORBIDDEN", "Admin privileges required", http.StatusForbidden}
+	ErrInvalidPeriod         = &APIError{"INVALID_PERIOD", "Statistics period must be a valid month or a date range of at most 366 days", http.StatusBadRequest}
+	ErrInvalidTxType         = &APIError{"INVALID_TRANSACTION_TYPE", "Invalid transaction type", http.StatusBadRequest}
+	ErrTransactionNotFound   = &APIError{"TRANSACTION_NOT_FOUND", "Transaction not found", http.StatusNotFound}
+	ErrNotReversible         = &APIError{"TRANSACTION_NOT_REVERSIBLE", "Only deposits and withdrawals can be reversed", http.StatusBadRequest}
+	ErrReversalAmount        = &APIError{"REVERSAL_EXCEEDS_ORIGINAL", "Reversals would exceed the original transaction amount", http.StatusBadRequest}
+	ErrHoldNotFound          = &APIError{"HOLD_NOT_FOUND", "Hold not found", http.StatusNotFound}
+	ErrHoldNotActive         = &APIError{"HOLD_NOT_ACTIVE", "Hold has already been captured, voided or expired", http.StatusConflict}
+	ErrHoldExpired           = &APIError{"HOLD_EXPIRED", "Hold has expired", http.StatusConflict}
+	ErrCaptureAmount         = &APIError{"CAPTURE_EXCEEDS_HOLD", "Capture amount exceeds the held amount", http.StatusBadRequest}
+	ErrInvalidEventType      = &APIError{"INVALID_EVENT_TYPE", "Invalid webhook event type", http.StatusBadRequest}
+	ErrInvalidWebhookURL     = &APIError{"INVALID_WEBHOOK_URL", "Webhook URL must use https and point to a public host", http.StatusBadRequest}
+	ErrSubscriptionNotFound  = &APIError{"WEBHOOK_SUBSCRIPTION_NOT_FOUND", "Webhook subscription not found", http.StatusNotFound}
+	ErrDeliveryNotFound      = &APIError{"WEBHOOK_DELIVERY_NOT_FOUND", "Webhook delivery not found", http.StatusNotFound}
+	ErrDeliveryPending       = &APIError{"WEBHOOK_DELIVERY_PENDING", "Webhook delivery is still queued", http.StatusConflict}
+	ErrInsufficientFloat     = &APIError{"INSUFFICIENT_FLOAT", "Partner float balance is insufficient", http.StatusBadRequest}
+	ErrPartnerNotFound       = &APIError{"PARTNER_NOT_FOUND", "Partner client not found", http.StatusNotFound}
+	ErrTopUpAlreadyApplied   = &APIError{"TOP_UP_ALREADY_APPLIED", "A float top-up with this reference was already applied", http.StatusConflict}
+	ErrInvalidFeeSchedule    = &APIError{"INVALID_FEE_SCHEDULE", "Fee rules are invalid or their amount bands overlap", http.StatusBadRequest}
+	ErrInvalidEffectiveDate  = &APIError{"INVALID_EFFECTIVE_DATE", "A fee schedule cannot take effect in the past", http.StatusBadRequest}
+	ErrFeeExceedsAmount      = &APIError{"FEE_EXCEEDS_AMOUNT", "The fee is not less than the operation amount", http.StatusBadRequest}
+	ErrInvalidBusinessDate   = &APIError{"INVALID_BUSINESS_DATE", "Only business days that have ended can be settled", http.StatusBadRequest}
+	ErrInvalidDateRange      = &APIError{"INVALID_DATE_RANGE", "Date range must be valid and at most 92 days long", http.StatusBadRequest}
+	ErrDuplicateExternalID   = &APIError{"DUPLICATE_EXTERNAL_ID", "A transaction with this external_id already exists", http.StatusConflict}
 )
 
 // GetStatusCode returns HTTP status code
@@ -2,7 +2,23 @@ package utils
 
 import "time"
 
-func GetCurrentMonth() time.Time {
-	now := time.Now()
-	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+// GetCurrentMonth returns midnight on the first day of the current month in loc
+func GetCurrentMonth(loc *time.Location) time.Time {
+	now := time.Now().In(loc)
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+}
+
+// MonthRange returns the half-open range [start, end) of the month containing t, in t's location.
+// Bounds are wall-clock midnights, so months spanning a DST change are not a fixed number of hours.
+func MonthRange(t time.Time) (time.Time, time.Time) {
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	return start, start.AddDate(0, 1, 0)
+}
+
+// DayRange returns the half-open range [start, end) covering the calendar days from first to last inclusive,
+// in first's location
+func DayRange(first, last time.Time) (time.Time, time.Time) {
+	start := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, first.Location())
+	end := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, first.Location()).AddDate(0, 0, 1)
+	return start, end
 }
@@ -0,0 +1,231 @@
+package utils
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // the zones below must resolve on hosts without a tz database
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load location %s: %v", name, err)
+	}
+	return loc
+}
+
+func TestMonthRange(t *testing.T) {
+	dushanbe := mustLoadLocation(t, "Asia/Dushanbe")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	tests := []struct {
+		name      string
+		t         time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+		wantHours float64
+	}{
+		{
+			name:      "last second of a month",
+			t:         time.Date(2025, time.January, 31, 23, 59, 59, 0, time.UTC),
+			wantStart: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			wantHours: 31 * 24,
+		},
+		{
+			name:      "first instant of a month",
+			t:         time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			wantHours: 28 * 24,
+		},
+		{
+			name:      "leap February",
+			t:         time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			wantHours: 29 * 24,
+		},
+		{
+			name:      "last second of December rolls into the next year",
+			t:         time.Date(2024, time.December, 31, 23, 59, 59, 0, dushanbe),
+			wantStart: time.Date(2024, time.December, 1, 0, 0, 0, 0, dushanbe),
+			wantEnd:   time.Date(2025, time.January, 1, 0, 0, 0, 0, dushanbe),
+			wantHours: 31 * 24,
+		},
+		{
+			name:      "first instant of January",
+			t:         time.Date(2025, time.January, 1, 0, 0, 0, 0, dushanbe),
+			wantStart: time.Date(2025, time.January, 1, 0, 0, 0, 0, dushanbe),
+			wantEnd:   time.Date(2025, time.February, 1, 0, 0, 0, 0, dushanbe),
+			wantHours: 31 * 24,
+		},
+		{
+			// 20:00 UTC on January 31 is already February 1 in Dushanbe (UTC+5)
+			name:      "instant still in January in UTC",
+			t:         time.Date(2025, time.January, 31, 20, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			wantHours: 31 * 24,
+		},
+		{
+			name:      "same instant already in February in Dushanbe",
+			t:         time.Date(2025, time.January, 31, 20, 0, 0, 0, time.UTC).In(dushanbe),
+			wantStart: time.Date(2025, time.January, 31, 19, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, time.February, 28, 19, 0, 0, 0, time.UTC),
+			wantHours: 28 * 24,
+		},
+		{
+			name:      "March loses an hour to the DST change in Berlin",
+			t:         time.Date(2025, time.March, 30, 12, 0, 0, 0, berlin),
+			wantStart: time.Date(2025, time.March, 1, 0, 0, 0, 0, berlin),
+			wantEnd:   time.Date(2025, time.April, 1, 0, 0, 0, 0, berlin),
+			wantHours: 31*24 - 1,
+		},
+		{
+			name:      "October gains an hour from the DST change in Berlin",
+			t:         time.Date(2025, time.October, 26, 12, 0, 0, 0, berlin),
+			wantStart: time.Date(2025, time.October, 1, 0, 0, 0, 0, berlin),
+			wantEnd:   time.Date(2025, time.November, 1, 0, 0, 0, 0, berlin),
+			wantHours: 31*24 + 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := MonthRange(tt.t)
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("start = %v, want %v", start, tt.wantStart)
+			}
+			if !end.Equal(tt.wantEnd) {
+				t.Errorf("end = %v, want %v", end, tt.wantEnd)
+			}
+			if hours := end.Sub(start).Hours(); hours != tt.wantHours {
+				t.Errorf("range is %v hours, want %v", hours, tt.wantHours)
+			}
+			if tt.t.Before(start) || !tt.t.Before(end) {
+				t.Errorf("%v is outside [%v, %v)", tt.t, start, end)
+			}
+		})
+	}
+}
+
+func TestDayRange(t *testing.T) {
+	dushanbe := mustLoadLocation(t, "Asia/Dushanbe")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	tests := []struct {
+		name      string
+		first     time.Time
+		last      time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+		wantHours float64
+	}{
+		{
+			name:      "single day",
+			first:     time.Date(2025, time.June, 15, 10, 0, 0, 0, dushanbe),
+			last:      time.Date(2025, time.June, 15, 10, 0, 0, 0, dushanbe),
+			wantStart: time.Date(2025, time.June, 15, 0, 0, 0, 0, dushanbe),
+			wantEnd:   time.Date(2025, time.June, 16, 0, 0, 0, 0, dushanbe),
+			wantHours: 24,
+		},
+		{
+			name:      "last second of the last day of a month",
+			first:     time.Date(2025, time.March, 31, 23, 59, 59, 0, dushanbe),
+			last:      time.Date(2025, time.March, 31, 23, 59, 59, 0, dushanbe),
+			wantStart: time.Date(2025, time.March, 31, 0, 0, 0, 0, dushanbe),
+			wantEnd:   time.Date(2025, time.April, 1, 0, 0, 0, 0, dushanbe),
+			wantHours: 24,
+		},
+		{
+			name:      "range across a month end",
+			first:     time.Date(2025, time.January, 30, 0, 0, 0, 0, dushanbe),
+			last:      time.Date(2025, time.February, 2, 0, 0, 0, 0, dushanbe),
+			wantStart: time.Date(2025, time.January, 30, 0, 0, 0, 0, dushanbe),
+			wantEnd:   time.Date(2025, time.February, 3, 0, 0, 0, 0, dushanbe),
+			wantHours: 4 * 24,
+		},
+		{
+			name:      "December 31 ends at the start of the next year",
+			first:     time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC),
+			last:      time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC),
+			wantStart: time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantHours: 24,
+		},
+		{
+			// 21:00 UTC on March 1 is already 02:00 on March 2 in Dushanbe
+			name:      "Dushanbe day starts five hours before the UTC day",
+			first:     time.Date(2025, time.March, 1, 21, 0, 0, 0, time.UTC).In(dushanbe),
+			last:      time.Date(2025, time.March, 1, 21, 0, 0, 0, time.UTC).In(dushanbe),
+			wantStart: time.Date(2025, time.March, 1, 19, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, time.March, 2, 19, 0, 0, 0, time.UTC),
+			wantHours: 24,
+		},
+		{
+			name:      "spring DST change in Berlin makes a 23 hour day",
+			first:     time.Date(2025, time.March, 30, 12, 0, 0, 0, berlin),
+			last:      time.Date(2025, time.March, 30, 12, 0, 0, 0, berlin),
+			wantStart: time.Date(2025, time.March, 30, 0, 0, 0, 0, berlin),
+			wantEnd:   time.Date(2025, time.March, 31, 0, 0, 0, 0, berlin),
+			wantHours: 23,
+		},
+		{
+			name:      "autumn DST change in Berlin makes a 25 hour day",
+			first:     time.Date(2025, time.October, 26, 12, 0, 0, 0, berlin),
+			last:      time.Date(2025, time.October, 26, 12, 0, 0, 0, berlin),
+			wantStart: time.Date(2025, time.October, 26, 0, 0, 0, 0, berlin),
+			wantEnd:   time.Date(2025, time.October, 27, 0, 0, 0, 0, berlin),
+			wantHours: 25,
+		},
+		{
+			name:      "week spanning the spring DST change in Berlin",
+			first:     time.Date(2025, time.March, 27, 0, 0, 0, 0, berlin),
+			last:      time.Date(2025, time.April, 2, 0, 0, 0, 0, berlin),
+			wantStart: time.Date(2025, time.March, 27, 0, 0, 0, 0, berlin),
+			wantEnd:   time.Date(2025, time.April, 3, 0, 0, 0, 0, berlin),
+			wantHours: 7*24 - 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := DayRange(tt.first, tt.last)
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("start = %v, want %v", start, tt.wantStart)
+			}
+			if !end.Equal(tt.wantEnd) {
+				t.Errorf("end = %v, want %v", end, tt.wantEnd)
+			}
+			if hours := end.Sub(start).Hours(); hours != tt.wantHours {
+				t.Errorf("range is %v hours, want %v", hours, tt.wantHours)
+			}
+		})
+	}
+}
+
+func TestGetCurrentMonth(t *testing.T) {
+	for _, name := range []string{"UTC", "Asia/Dushanbe", "Europe/Berlin"} {
+		t.Run(name, func(t *testing.T) {
+			loc := mustLoadLocation(t, name)
+
+			before := time.Now().In(loc)
+			got := GetCurrentMonth(loc)
+			after := time.Now().In(loc)
+
+			if got.Location() != loc {
+				t.Errorf("location = %v, want %v", got.Location(), loc)
+			}
+			if got.Day() != 1 || got.Hour() != 0 || got.Minute() != 0 || got.Second() != 0 || got.Nanosecond() != 0 {
+				t.Errorf("got %v, want midnight on the first of a month", got)
+			}
+			// The month may roll over between the two readings of the clock
+			if (got.Year() != before.Year() || got.Month() != before.Month()) &&
+				(got.Year() != after.Year() || got.Month() != after.Month()) {
+				t.Errorf("got %v, want the month of %v", got, before)
+			}
+		})
+	}
+}
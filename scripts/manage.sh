@@ -97,7 +97,7 @@ case "${1}" in
         
         print_info "Truncating existing data..."
         PGPASSWORD=$POSTGRES_PASSWORD psql -h "$DB_HOST" -p "$DB_PORT" -U "$DB_USER" -d "$DB_NAME" \
-            -c "TRUNCATE api_clients, wallets, transactions RESTART IDENTITY CASCADE;" 2>/dev/null || true
+            -c "TRUNCATE api_clients, wallets, transactions, holds, webhook_subscriptions, webhook_outbox, fee_schedules, fee_rules, settlements, ledger_accounts, journal_entries, ledger_postings RESTART IDENTITY CASCADE;" 2>/dev/null || true
         
         print_info "Inserting seed data..."
         PGPASSWORD=$POSTGRES_PASSWORD psql -h "$DB_HOST" -p "$DB_PORT" -U "$DB_USER" -d "$DB_NAME" -f scripts/seed.sql
@@ -140,7 +140,7 @@ case "${1}" in
         
         print_info "Seeding database..."
         PGPASSWORD=$POSTGRES_PASSWORD psql -h "$DB_HOST" -p "$DB_PORT" -U "$DB_USER" -d "$DB_NAME" \
-            -c "TRUNCATE api_clients, wallets, transactions RESTART IDENTITY CASCADE;" 2>/dev/null || true
+            -c "TRUNCATE api_clients, wallets, transactions, holds, webhook_subscriptions, webhook_outbox, fee_schedules, fee_rules, settlements, ledger_accounts, journal_entries, ledger_postings RESTART IDENTITY CASCADE;" 2>/dev/null || true
         PGPASSWORD=$POSTGRES_PASSWORD psql -h "$DB_HOST" -p "$DB_PORT" -U "$DB_USER" -d "$DB_NAME" -f scripts/seed.sql
         
         print_success "Environment ready!"
@@ -182,6 +182,37 @@ case "${1}" in
         bash scripts/test-api.sh
         ;;
     
+    test-concurrency)
+        print_header "RUNNING CONCURRENCY TEST"
+        
+        # Check if app is running
+        if ! curl -s http://localhost:8080/health > /dev/null 2>&1; then
+            print_error "Application is not running!"
+            print_info "Start the app first: ./scripts/manage.sh dev"
+            exit 1
+        fi
+        
+        print_info "Firing parallel deposits..."
+        bash scripts/test-concurrency.sh
+        ;;
+    
+    test-go)
+        print_header "RUNNING GO TESTS"
+        
+        # Database tests create their own partner and wallets in the configured database
+        export TEST_DATABASE_DSN="host=$DB_HOST port=$DB_PORT user=$DB_USER password=$POSTGRES_PASSWORD dbname=$DB_NAME sslmode=disable"
+        
+        print_info "Executing go test..."
+        go test -count=1 ./...
+        ;;
+    
+    reconcile)
+        print_header "RECONCILING WALLET BALANCES"
+        
+        print_info "Comparing wallet balances with transactions..."
+        go run cmd/reconcile/main.go
+        ;;
+    
     swagger)
         print_header "GENERATING SWAGGER DOCS"
         
@@ -279,7 +310,10 @@ case "${1}" in
         echo "  build     - Build application binary"
         echo "  run       - Run application from binary"
         echo "  test      - Run API tests"
+        echo "  test-concurrency - Run parallel deposit test"
+        echo "  test-go   - Run Go tests, including the database concurrency tests"
         echo "  hmac      - Run HMAC generator tool"
+        echo "  reconcile - Reconcile wallet balances with transactions"
         echo ""
         echo "Database Commands:"
         echo "  init      - Initialize database (create DB + extensions)"
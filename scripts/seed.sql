@@ -8,25 +8,45 @@ VALUES
     ('tcell_integration', 'tcell_hmac_key', true, NOW(), NOW())
 ON CONFLICT (user_id) DO NOTHING;
 
--- Unidentified wallets (max 10,000 TJS)
-INSERT INTO wallets (account_id, type, balance, created_at, updated_at)
-VALUES 
-    ('992900123456', 'unidentified', 250000, NOW(), NOW()),
-    ('992935789012', 'unidentified', 500000, NOW(), NOW()),
-    ('992918765432', 'unidentified', 750000, NOW(), NOW()),
-    ('992987654321', 'unidentified', 1000000, NOW(), NOW()),
-    ('992901234567', 'unidentified', 0, NOW(), NOW())
-ON CONFLICT (account_id) DO NOTHING;
-
--- Identified wallets (max 100,000 TJS)
-INSERT INTO wallets (account_id, type, balance, created_at, updated_at)
-VALUES 
-    ('992900111222', 'identified', 2500000, NOW(), NOW()),
-    ('992935333444', 'identified', 5000000, NOW(), NOW()),
-    ('992918555666', 'identified', 7500000, NOW(), NOW()),
-    ('992987777888', 'identified', 10000000, NOW(), NOW()),
-    ('992901999000', 'identified', 0, NOW(), NOW())
-ON CONFLICT (account_id) DO NOTHING;
+-- Admin client for /api/v1/admin endpoints
+INSERT INTO api_clients (user_id, secret_key, is_active, is_admin, created_at, updated_at)
+VALUES ('ewallet_admin', 'admin_secret_2025', true, true, NOW(), NOW())
+ON CONFLICT (user_id) DO NOTHING;
+
+-- Unidentified wallets (max 10,000 TJS), owned by alif_partner
+INSERT INTO wallets (account_id, type, balance, owner_client_id, created_at, updated_at)
+SELECT w.account_id, 'unidentified', w.balance, c.id, NOW(), NOW()
+FROM (VALUES
+    ('992900123456', 250000),
+    ('992935789012', 500000),
+    ('992918765432', 750000),
+    ('992987654321', 1000000),
+    ('992901234567', 0)
+) AS w(account_id, balance)
+CROSS JOIN api_clients c
+WHERE c.user_id = 'alif_partner'
+ON CONFLICT (account_id, owner_client_id) DO NOTHING;
+
+-- Identified wallets (max 100,000 TJS), owned by alif_partner
+INSERT INTO wallets (account_id, type, balance, owner_client_id, identification_status, created_at, updated_at)
+SELECT w.account_id, 'identified', w.balance, c.id, 'identified', NOW(), NOW()
+FROM (VALUES
+    ('992900111222', 2500000),
+    ('992935333444', 5000000),
+    ('992918555666', 7500000),
+    ('992987777888', 10000000),
+    ('992901999000', 0)
+) AS w(account_id, balance)
+CROSS JOIN api_clients c
+WHERE c.user_id = 'alif_partner'
+ON CONFLICT (account_id, owner_client_id) DO NOTHING;
+
+-- Wallet owned by megafon_api (invisible to other partners)
+INSERT INTO wallets (account_id, type, balance, owner_client_id, created_at, updated_at)
+SELECT '992927000111', 'unidentified', 100000, c.id, NOW(), NOW()
+FROM api_clients c
+WHERE c.user_id = 'megafon_api'
+ON CONFLICT (account_id, owner_client_id) DO NOTHING;
 
 -- Sample transactions for testing monthly stats
 DO $$
@@ -34,7 +54,7 @@ DECLARE
     w_id BIGINT;
 BEGIN
     SELECT id INTO w_id FROM wallets WHERE account_id = '992900123456' LIMIT 1;
-    IF w_id IS NOT NULL THEN
+    IF w_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM transactions WHERE wallet_id = w_id) THEN
         INSERT INTO transactions (wallet_id, type, amount, created_at)
         VALUES 
             (w_id, 'deposit', 50000, NOW() - INTERVAL '2 days'),
@@ -43,7 +63,7 @@ BEGIN
     END IF;
     
     SELECT id INTO w_id FROM wallets WHERE account_id = '992900111222' LIMIT 1;
-    IF w_id IS NOT NULL THEN
+    IF w_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM transactions WHERE wallet_id = w_id) THEN
         INSERT INTO transactions (wallet_id, type, amount, created_at)
         VALUES 
             (w_id, 'deposit', 250000, NOW() - INTERVAL '1 day'),
@@ -51,3 +71,57 @@ BEGIN
             (w_id, 'deposit', 1000000, NOW() - INTERVAL '10 days');
     END IF;
 END $$;
+
+-- Opening balances: the part of each seeded balance not explained by its transactions,
+-- so balances recomputed by reconciliation match wallets.balance
+INSERT INTO transactions (wallet_id, type, amount, balance_after, reference, created_at)
+SELECT w.id, 'opening_balance', w.balance - t.net, w.balance - t.net, 'opening:' || w.id, NOW() - INTERVAL '30 days'
+FROM wallets w
+CROSS JOIN LATERAL (
+    SELECT COALESCE(SUM(CASE
+        WHEN type IN ('deposit', 'transfer_in') THEN amount
+        WHEN type IN ('withdrawal', 'transfer_out') THEN -amount
+        ELSE 0
+    END), 0) AS net
+    FROM transactions
+    WHERE wallet_id = w.id
+) t
+WHERE w.balance - t.net > 0
+  AND NOT EXISTS (SELECT 1 FROM transactions o WHERE o.wallet_id = w.id AND o.type = 'opening_balance');
+
+-- Partner floats: every partner starts prefunded with 1,000,000 TJS from the settlement account,
+-- recorded as a balanced float_top_up entry so the ledger trial balance stays at zero
+DO $$
+DECLARE
+    partner RECORD;
+    settlement_id BIGINT;
+    float_id BIGINT;
+    entry_id BIGINT;
+    float_amount BIGINT := 100000000;
+BEGIN
+    INSERT INTO ledger_accounts (code, type, balance, created_at, updated_at)
+    VALUES ('system:settlement', 'settlement', 0, NOW(), NOW())
+    ON CONFLICT (code) DO NOTHING;
+    SELECT id INTO settlement_id FROM ledger_accounts WHERE code = 'system:settlement';
+
+    FOR partner IN
+        SELECT c.id FROM api_clients c
+        WHERE NOT c.is_admin
+          AND NOT EXISTS (SELECT 1 FROM ledger_accounts a WHERE a.code = 'partner_float:' || c.id)
+    LOOP
+        INSERT INTO ledger_accounts (code, type, client_id, balance, created_at, updated_at)
+        VALUES ('partner_float:' || partner.id, 'partner_float', partner.id, float_amount, NOW(), NOW())
+        RETURNING id INTO float_id;
+
+        INSERT INTO journal_entries (kind, reference, created_at)
+        VALUES ('float_top_up', 'seed:float:' || partner.id, NOW())
+        RETURNING id INTO entry_id;
+
+        INSERT INTO ledger_postings (journal_entry_id, account_id, direction, amount, created_at)
+        VALUES
+            (entry_id, settlement_id, 'debit', float_amount, NOW()),
+            (entry_id, float_id, 'credit', float_amount, NOW());
+
+        UPDATE ledger_accounts SET balance = balance - float_amount, updated_at = NOW() WHERE id = settlement_id;
+    END LOOP;
+END $$;
@@ -8,6 +8,8 @@ set -e
 API_URL="http://localhost:8080/api/v1"
 USER_ID="alif_partner"
 SECRET_KEY="alif_secret_2025"
+ADMIN_USER_ID="ewallet_admin"
+ADMIN_SECRET_KEY="admin_secret_2025"
 
 # Colors for output
 GREEN='\033[0;32m'
@@ -21,21 +23,28 @@ compute_hmac() {
     echo -n "$data" | openssl dgst -sha1 -hmac "$SECRET_KEY" | cut -d' ' -f2
 }
 
-# Function to make API request (expects success)
+# Function to compute the headers of a signed request, with an Idempotency-Key when one is given
+request_headers() {
+    local data="$1"
+    local key="$2"
+
+    headers=(-H "Content-Type: application/json" -H "X-UserId: $USER_ID" -H "X-Digest: $(compute_hmac "$data")")
+    if [ -n "$key" ]; then
+        headers+=(-H "Idempotency-Key: $key")
+    fi
+}
+
+# Function to make API request (expects success); the optional third argument is an Idempotency-Key
 api_request() {
     local endpoint="$1"
     local data="$2"
-    # shellcheck disable=SC2155
-    local digest=$(compute_hmac "$data")
+    local headers
+    request_headers "$data" "$3"
     
     echo -e "${YELLOW}Testing: $endpoint${NC}"
     echo "Request: $data"
     
-    response=$(curl -s -X POST "$API_URL$endpoint" \
-        -H "Content-Type: application/json" \
-        -H "X-UserId: $USER_ID" \
-        -H "X-Digest: $digest" \
-        -d "$data")
+    response=$(curl -s -X POST "$API_URL$endpoint" "${headers[@]}" -d "$data")
     
     echo "Response: $response"
     echo ""
@@ -49,22 +58,18 @@ api_request() {
     fi
 }
 
-# Function to make API request (expects error)
+# Function to make API request (expects error); the optional fourth argument is an Idempotency-Key
 api_request_error() {
     local endpoint="$1"
     local data="$2"
     local expected_error="$3"
-    # shellcheck disable=SC2155
-    local digest=$(compute_hmac "$data")
+    local headers
+    request_headers "$data" "$4"
     
     echo -e "${YELLOW}Testing: $endpoint${NC}"
     echo "Request: $data"
     
-    response=$(curl -s -X POST "$API_URL$endpoint" \
-        -H "Content-Type: application/json" \
-        -H "X-UserId: $USER_ID" \
-        -H "X-Digest: $digest" \
-        -d "$data")
+    response=$(curl -s -X POST "$API_URL$endpoint" "${headers[@]}" -d "$data")
     
     echo "Response: $response"
     echo ""
@@ -78,6 +83,21 @@ api_request_error() {
     fi
 }
 
+# Function to make API request as the admin client (expects success)
+admin_request() {
+    (USER_ID="$ADMIN_USER_ID"; SECRET_KEY="$ADMIN_SECRET_KEY"; api_request "$@")
+}
+
+# Function to make a signed API request with an Idempotency-Key header and print the raw response
+api_request_idempotent() {
+    local endpoint="$1"
+    local data="$2"
+    local headers
+    request_headers "$data" "$3"
+
+    curl -s -X POST "$API_URL$endpoint" "${headers[@]}" -d "$data"
+}
+
 echo "========================================="
 echo "E-Wallet API Test Suite"
 echo "========================================="
@@ -125,8 +145,289 @@ api_request_error "/wallet/deposit" '{"account_id":"992900123456","amount":-1000
 echo "========================================="
 echo ""
 
-# Test 8: Missing authentication
-echo -e "${YELLOW}Test 8: Missing Authentication (should fail)${NC}"
+# Test 8: Deposit exceeding the single operation limit
+echo -e "${YELLOW}Test 8: Deposit Exceeding Operation Limit (should fail)${NC}"
+api_request_error "/wallet/deposit" '{"account_id":"992900123456","amount":600000}' "OPERATION_LIMIT_EXCEEDED"
+echo "========================================="
+echo ""
+
+# Test 9: Withdraw from wallet
+echo -e "${YELLOW}Test 9: Withdraw from Wallet${NC}"
+api_request "/wallet/withdraw" '{"account_id":"992900123456","amount":5000}'
+echo "========================================="
+echo ""
+
+# Test 10: Withdrawal exceeding balance
+echo -e "${YELLOW}Test 10: Withdrawal Exceeding Balance (should fail)${NC}"
+api_request_error "/wallet/withdraw" '{"account_id":"992901234567","amount":100}' "INSUFFICIENT_FUNDS"
+echo "========================================="
+echo ""
+
+# Test 11: Transfer between wallets
+echo -e "${YELLOW}Test 11: Transfer Between Wallets${NC}"
+api_request "/wallet/transfer" '{"from_account_id":"992900111222","to_account_id":"992900123456","amount":5000}'
+echo "========================================="
+echo ""
+
+# Test 12: Transfer exceeding destination limit
+echo -e "${YELLOW}Test 12: Transfer Exceeding Destination Limit (should fail)${NC}"
+api_request_error "/wallet/transfer" '{"from_account_id":"992935333444","to_account_id":"992987654321","amount":100}' "BALANCE_LIMIT_EXCEEDED"
+echo "========================================="
+echo ""
+
+# Test 13: Idempotent deposit replay
+echo -e "${YELLOW}Test 13: Idempotent Deposit Replay${NC}"
+IDEMPOTENCY_KEY="test-$(date +%s)-$RANDOM"
+first_response=$(api_request_idempotent "/wallet/deposit" '{"account_id":"992900123456","amount":1000}' "$IDEMPOTENCY_KEY")
+second_response=$(api_request_idempotent "/wallet/deposit" '{"account_id":"992900123456","amount":1000}' "$IDEMPOTENCY_KEY")
+echo "First:  $first_response"
+echo "Replay: $second_response"
+if [ "$first_response" = "$second_response" ] && echo "$first_response" | grep -q '"success":true'; then
+    echo -e "${GREEN}✓ Test passed${NC}"
+else
+    echo -e "${RED}✗ Test failed (replay should return the original response)${NC}"
+fi
+echo "========================================="
+echo ""
+
+# Test 14: Idempotency key reused with a different body
+echo -e "${YELLOW}Test 14: Idempotency Key Reused With Different Body (should fail)${NC}"
+response=$(api_request_idempotent "/wallet/deposit" '{"account_id":"992900123456","amount":2000}' "$IDEMPOTENCY_KEY")
+echo "Response: $response"
+if echo "$response" | grep -q "IDEMPOTENCY_KEY_REUSED"; then
+    echo -e "${GREEN}✓ Test passed (error expected)${NC}"
+else
+    echo -e "${RED}✗ Test failed${NC}"
+fi
+echo "========================================="
+echo ""
+
+# Test 15: Wallet of another partner
+echo -e "${YELLOW}Test 15: Wallet Of Another Partner (should fail)${NC}"
+api_request_error "/wallet/balance" '{"account_id":"992927000111"}' "WALLET_NOT_FOUND"
+echo "========================================="
+echo ""
+
+# Test 16: Transaction history
+echo -e "${YELLOW}Test 16: Transaction History${NC}"
+api_request "/wallet/transactions" '{"account_id":"992900123456","limit":5}'
+echo "========================================="
+echo ""
+
+# Test 17: Invalid history cursor
+echo -e "${YELLOW}Test 17: Invalid History Cursor (should fail)${NC}"
+api_request_error "/wallet/transactions" '{"account_id":"992900123456","cursor":"not-a-cursor"}' "INVALID_CURSOR"
+echo "========================================="
+echo ""
+
+# Test 18: Wallet tiers
+echo -e "${YELLOW}Test 18: Wallet Tiers${NC}"
+api_request "/wallet/tiers" "{}"
+echo "========================================="
+echo ""
+
+# Test 19: Wallet limits
+echo -e "${YELLOW}Test 19: Wallet Limits${NC}"
+api_request "/wallet/limits" '{"account_id":"992900123456"}'
+echo "========================================="
+echo ""
+
+# Test 20: Reverse a deposit in two parts, replay a part, then reverse beyond its amount and without a key
+echo -e "${YELLOW}Test 20: Reverse Deposit In Parts (beyond the amount and without a key should fail)${NC}"
+api_request "/wallet/deposit" '{"account_id":"992900123456","amount":1000}'
+DEPOSIT_TX_ID=$(echo "$response" | grep -o '"transaction_id":[0-9]*' | cut -d: -f2)
+REVERSAL_KEY="reverse-$(date +%s)-$RANDOM"
+api_request "/transaction/reverse" "{\"transaction_id\":$DEPOSIT_TX_ID,\"amount\":400}" "$REVERSAL_KEY-1"
+first_response="$response"
+api_request "/transaction/reverse" "{\"transaction_id\":$DEPOSIT_TX_ID,\"amount\":400}" "$REVERSAL_KEY-1"
+if [ "$first_response" = "$response" ]; then
+    echo -e "${GREEN}✓ Replayed reversal returned the first response${NC}"
+else
+    echo -e "${RED}✗ Replayed reversal should return the first response${NC}"
+fi
+api_request "/transaction/reverse" "{\"transaction_id\":$DEPOSIT_TX_ID,\"amount\":500}" "$REVERSAL_KEY-2"
+api_request_error "/transaction/reverse" "{\"transaction_id\":$DEPOSIT_TX_ID,\"amount\":200}" "REVERSAL_EXCEEDS_ORIGINAL" "$REVERSAL_KEY-3"
+api_request_error "/transaction/reverse" "{\"transaction_id\":$DEPOSIT_TX_ID,\"amount\":100}" "IDEMPOTENCY_KEY_REQUIRED"
+echo "========================================="
+echo ""
+
+# Test 21: Deposit with an external ID, look it up, then reuse the external ID
+echo -e "${YELLOW}Test 21: External ID Lookup (reused external ID should fail)${NC}"
+EXTERNAL_ID="PAY-$(date +%s)-$RANDOM"
+api_request "/wallet/deposit" "{\"account_id\":\"992900123456\",\"amount\":700,\"external_id\":\"$EXTERNAL_ID\",\"description\":\"Cash-in at branch 12\",\"metadata\":{\"terminal\":\"T-0042\"}}"
+api_request "/transaction/get" "{\"external_id\":\"$EXTERNAL_ID\"}"
+api_request_error "/wallet/deposit" "{\"account_id\":\"992900123456\",\"amount\":700,\"external_id\":\"$EXTERNAL_ID\"}" "DUPLICATE_EXTERNAL_ID"
+echo "========================================="
+echo ""
+
+# Test 22: Check the status of a deposit by external ID, reverse it, check again, then an unknown external ID
+echo -e "${YELLOW}Test 22: Transaction Status (unknown external ID should fail)${NC}"
+EXTERNAL_ID="PAY-$(date +%s)-$RANDOM"
+api_request "/wallet/deposit" "{\"account_id\":\"992900123456\",\"amount\":500,\"external_id\":\"$EXTERNAL_ID\"}"
+DEPOSIT_TX_ID=$(echo "$response" | grep -o '"transaction_id":[0-9]*' | cut -d: -f2)
+api_request "/transaction/status" "{\"external_id\":\"$EXTERNAL_ID\"}"
+api_request "/transaction/reverse" "{\"transaction_id\":$DEPOSIT_TX_ID}" "reverse-$EXTERNAL_ID"
+api_request "/transaction/status" "{\"transaction_id\":$DEPOSIT_TX_ID}"
+api_request_error "/transaction/status" "{\"external_id\":\"$EXTERNAL_ID-unknown\"}" "TRANSACTION_NOT_FOUND"
+echo "========================================="
+echo ""
+
+# Test 23: Authorize and capture part of a hold, authorize and void another
+echo -e "${YELLOW}Test 23: Hold Authorize, Capture And Void (second capture should fail)${NC}"
+HOLD_KEY="hold-$(date +%s)-$RANDOM"
+api_request "/hold/authorize" '{"account_id":"992900123456","amount":1500}' "$HOLD_KEY-authorize"
+HOLD_ID=$(echo "$response" | grep -o '"hold_id":[0-9]*' | cut -d: -f2)
+api_request "/hold/authorize" '{"account_id":"992900123456","amount":1500}' "$HOLD_KEY-authorize"
+if [ "$(echo "$response" | grep -o '"hold_id":[0-9]*' | cut -d: -f2)" = "$HOLD_ID" ]; then
+    echo -e "${GREEN}✓ Replayed authorization returned the same hold${NC}"
+else
+    echo -e "${RED}✗ Replayed authorization should return the same hold${NC}"
+fi
+api_request "/hold/capture" "{\"hold_id\":$HOLD_ID,\"amount\":1000}" "$HOLD_KEY-capture"
+api_request "/hold/capture" "{\"hold_id\":$HOLD_ID,\"amount\":1000}" "$HOLD_KEY-capture"
+api_request_error "/hold/capture" "{\"hold_id\":$HOLD_ID}" "HOLD_NOT_ACTIVE"
+api_request "/hold/authorize" '{"account_id":"992900123456","amount":500}'
+HOLD_ID=$(echo "$response" | grep -o '"hold_id":[0-9]*' | cut -d: -f2)
+api_request "/hold/void" "{\"hold_id\":$HOLD_ID}"
+echo "========================================="
+echo ""
+
+# Test 24: Subscribe to webhook events, list subscriptions and deliveries, then unsubscribe
+echo -e "${YELLOW}Test 24: Webhook Subscribe, List And Unsubscribe${NC}"
+api_request "/webhook/subscribe" '{"url":"https://partner.example/hooks/wallet","event_types":["deposit.completed"]}'
+SUBSCRIPTION_ID=$(echo "$response" | grep -o '"id":[0-9]*' | head -1 | cut -d: -f2)
+api_request "/wallet/deposit" '{"account_id":"992900123456","amount":100}'
+api_request "/webhook/subscriptions" "{}"
+api_request "/webhook/deliveries" '{"status":"pending","limit":5}'
+api_request "/webhook/unsubscribe" "{\"subscription_id\":$SUBSCRIPTION_ID}"
+api_request_error "/webhook/subscribe" '{"url":"https://partner.example/hooks/wallet","event_types":["wallet.unknown"]}' "INVALID_EVENT_TYPE"
+api_request_error "/webhook/subscribe" '{"url":"https://169.254.169.254/latest/meta-data","event_types":[]}' "INVALID_WEBHOOK_URL"
+echo "========================================="
+echo ""
+
+# Test 25: Read the partner float, top it up as admin, then repeat the top-up
+echo -e "${YELLOW}Test 25: Float Statement And Top-Up (repeated reference should fail)${NC}"
+api_request "/float/statement" '{"limit":5}'
+TOP_UP_REFERENCE="TEST-$(date +%s)"
+admin_request "/admin/float/top-up" "{\"user_id\":\"alif_partner\",\"amount\":100000,\"reference\":\"$TOP_UP_REFERENCE\"}"
+(USER_ID="$ADMIN_USER_ID"; SECRET_KEY="$ADMIN_SECRET_KEY"; api_request_error "/admin/float/top-up" "{\"user_id\":\"alif_partner\",\"amount\":100000,\"reference\":\"$TOP_UP_REFERENCE\"}" "TOP_UP_ALREADY_APPLIED")
+echo "========================================="
+echo ""
+
+# Test 26: Price megafon_api deposits, deposit with the fee, reverse it, then try overlapping bands
+echo -e "${YELLOW}Test 26: Fee Schedule, Deposit With Fee And Its Refund (overlapping bands should fail)${NC}"
+admin_request "/admin/fees/schedule" '{"user_id":"megafon_api","rules":[{"operation":"deposit","fixed_fee":100,"percent_bps":100,"min_fee":200,"max_fee":5000}]}'
+fee_output=$(USER_ID="megafon_api"; SECRET_KEY="megafon_key_secure"; api_request "/wallet/deposit" '{"account_id":"992927000111","amount":10000}')
+echo "$fee_output"
+if echo "$fee_output" | grep -q '"fee":200,"net_amount":9800'; then
+    echo -e "${GREEN}✓ Fee of 200 dirams charged${NC}"
+else
+    echo -e "${RED}✗ Expected a fee of 200 dirams${NC}"
+fi
+FEE_DEPOSIT_TX_ID=$(echo "$fee_output" | grep -o '"transaction_id":[0-9]*' | head -1 | cut -d: -f2)
+refund_output=$(USER_ID="megafon_api"; SECRET_KEY="megafon_key_secure"; api_request "/transaction/reverse" "{\"transaction_id\":$FEE_DEPOSIT_TX_ID}" "reverse-fee-$FEE_DEPOSIT_TX_ID")
+echo "$refund_output"
+if echo "$refund_output" | grep -q '"fee_refund":200'; then
+    echo -e "${GREEN}✓ Fee of 200 dirams refunded by the reversal${NC}"
+else
+    echo -e "${RED}✗ Expected the reversal to refund the fee of 200 dirams${NC}"
+fi
+(USER_ID="$ADMIN_USER_ID"; SECRET_KEY="$ADMIN_SECRET_KEY"; api_request_error "/admin/fees/schedule" '{"user_id":"megafon_api","rules":[{"operation":"deposit","max_amount":50000,"fixed_fee":100},{"operation":"deposit","min_amount":40000,"fixed_fee":300}]}' "INVALID_FEE_SCHEDULE")
+echo "========================================="
+echo ""
+
+# Test 27: Settle yesterday as admin, read the report and CSV, then try to settle today
+echo -e "${YELLOW}Test 27: Daily Settlements (settling today should fail)${NC}"
+YESTERDAY=$(TZ=Asia/Dushanbe date -d yesterday +%F 2>/dev/null || TZ=Asia/Dushanbe date -v-1d +%F)
+TODAY=$(TZ=Asia/Dushanbe date +%F)
+admin_request "/admin/settlement/run" "{\"business_date\":\"$YESTERDAY\"}"
+api_request "/settlement/report" "{\"from\":\"$YESTERDAY\",\"to\":\"$TODAY\"}"
+api_request "/settlement/report/csv" "{\"from\":\"$YESTERDAY\",\"to\":\"$TODAY\"}"
+(USER_ID="$ADMIN_USER_ID"; SECRET_KEY="$ADMIN_SECRET_KEY"; api_request_error "/admin/settlement/run" "{\"business_date\":\"$TODAY\"}" "INVALID_BUSINESS_DATE")
+echo "========================================="
+echo ""
+
+# Test 28: Create wallet, then create it again and skip identification; another partner may reuse the account ID
+echo -e "${YELLOW}Test 28: Create Wallet, Duplicate And Identified (both should fail)${NC}"
+NEW_ACCOUNT_ID="9929$(date +%s | tail -c 9)"
+api_request "/wallet/create" "{\"account_id\":\"$NEW_ACCOUNT_ID\"}"
+api_request_error "/wallet/create" "{\"account_id\":\"$NEW_ACCOUNT_ID\"}" "ALREADY_EXISTS"
+(USER_ID="megafon_api"; SECRET_KEY="megafon_key_secure"; api_request "/wallet/create" "{\"account_id\":\"$NEW_ACCOUNT_ID\"}")
+(USER_ID="$ADMIN_USER_ID"; SECRET_KEY="$ADMIN_SECRET_KEY"; api_request_error "/admin/wallet/status/history" "{\"account_id\":\"$NEW_ACCOUNT_ID\"}" "WALLET_AMBIGUOUS")
+admin_request "/admin/wallet/status/history" "{\"account_id\":\"$NEW_ACCOUNT_ID\",\"user_id\":\"megafon_api\"}"
+api_request_error "/wallet/create" "{\"account_id\":\"${NEW_ACCOUNT_ID}1\",\"wallet_type\":\"identified\"}" "IDENTIFICATION_REQUIRED"
+echo "========================================="
+echo ""
+
+# Test 29: Submit wallet identification
+echo -e "${YELLOW}Test 29: Submit Wallet Identification${NC}"
+api_request "/wallet/identify" "{\"account_id\":\"$NEW_ACCOUNT_ID\",\"full_name\":\"Rustam Nazarov\",\"document_type\":\"passport\",\"document_number\":\"A1234567\",\"date_of_birth\":\"1990-04-12\"}"
+echo "========================================="
+echo ""
+
+# Test 30: Approve identification as admin
+echo -e "${YELLOW}Test 30: Approve Identification As Admin${NC}"
+admin_request "/admin/wallet/identification/approve" "{\"account_id\":\"$NEW_ACCOUNT_ID\",\"user_id\":\"$USER_ID\"}"
+echo "========================================="
+echo ""
+
+# Test 31: Admin endpoint as a partner
+echo -e "${YELLOW}Test 31: Admin Endpoint As A Partner (should fail)${NC}"
+api_request_error "/admin/wallet/identification/approve" "{\"account_id\":\"$NEW_ACCOUNT_ID\"}" "FORBIDDEN"
+echo "========================================="
+echo ""
+
+# Test 32: Freeze wallet as admin
+echo -e "${YELLOW}Test 32: Freeze Wallet As Admin${NC}"
+admin_request "/admin/wallet/status" "{\"account_id\":\"$NEW_ACCOUNT_ID\",\"user_id\":\"$USER_ID\",\"status\":\"frozen\",\"reason\":\"Suspicious activity reported\"}"
+echo "========================================="
+echo ""
+
+# Test 33: Withdraw from a frozen wallet
+echo -e "${YELLOW}Test 33: Withdraw From A Frozen Wallet (should fail)${NC}"
+api_request_error "/wallet/withdraw" "{\"account_id\":\"$NEW_ACCOUNT_ID\",\"amount\":100}" "WALLET_FROZEN"
+echo "========================================="
+echo ""
+
+# Test 34: Close wallet, then read its balance
+echo -e "${YELLOW}Test 34: Close Wallet And Read Balance (should fail)${NC}"
+admin_request "/admin/wallet/status" "{\"account_id\":\"$NEW_ACCOUNT_ID\",\"user_id\":\"$USER_ID\",\"status\":\"closed\",\"reason\":\"Customer request\"}"
+api_request_error "/wallet/balance" "{\"account_id\":\"$NEW_ACCOUNT_ID\"}" "WALLET_CLOSED"
+echo "========================================="
+echo ""
+
+# Test 35: Ledger trial balance
+echo -e "${YELLOW}Test 35: Ledger Trial Balance${NC}"
+ledger_output=$(admin_request "/admin/ledger/trial-balance" "{}")
+echo "$ledger_output"
+if echo "$ledger_output" | grep -q '"balanced":true'; then
+    echo -e "${GREEN}✓ Ledger is balanced${NC}"
+else
+    echo -e "${RED}✗ Ledger is out of balance${NC}"
+fi
+echo "========================================="
+echo ""
+
+# Test 36: Balance reconciliation
+echo -e "${YELLOW}Test 36: Balance Reconciliation${NC}"
+reconciliation_output=$(admin_request "/admin/reconciliation/run" "{}")
+echo "$reconciliation_output"
+if echo "$reconciliation_output" | grep -q '"balanced":true'; then
+    echo -e "${GREEN}✓ All wallet balances match their transactions${NC}"
+else
+    echo -e "${RED}✗ Reconciliation found discrepancies${NC}"
+fi
+echo "========================================="
+echo ""
+
+# Test 37: Weekly statistics for a date range
+echo -e "${YELLOW}Test 37: Weekly Statistics For A Date Range${NC}"
+api_request "/wallet/monthly-stats" "{\"account_id\":\"992900123456\",\"from\":\"$(date +%Y-%m-01)\",\"to\":\"$(date +%Y-%m-%d)\",\"group_by\":\"week\"}"
+echo "========================================="
+echo ""
+
+# Test 38: Missing authentication
+echo -e "${YELLOW}Test 38: Missing Authentication (should fail)${NC}"
 response=$(curl -s -X POST "$API_URL/wallet/balance" \
     -H "Content-Type: application/json" \
     -d '{"account_id":"992900123456"}')
@@ -139,8 +440,8 @@ fi
 echo "========================================="
 echo ""
 
-# Test 9: Invalid HMAC signature
-echo -e "${YELLOW}Test 9: Invalid HMAC Signature (should fail)${NC}"
+# Test 39: Invalid HMAC signature
+echo -e "${YELLOW}Test 39: Invalid HMAC Signature (should fail)${NC}"
 response=$(curl -s -X POST "$API_URL/wallet/balance" \
     -H "Content-Type: application/json" \
     -H "X-UserId: $USER_ID" \
@@ -0,0 +1,116 @@
+#!/bin/bash
+
+# E-Wallet Concurrency Test
+# Fires parallel deposits at one wallet and checks that no update was lost
+# and the balance limit was never exceeded.
+#
+# The rate limiter counts every request, so run the server with
+# rate_limiter.requests_per_window above CONCURRENT_DEPOSITS.
+# Turnover limits (limits.unidentified.daily_count etc.) reject the rest once reached.
+
+set -e
+
+API_URL="http://localhost:8080/api/v1"
+USER_ID="alif_partner"
+SECRET_KEY="alif_secret_2025"
+
+ACCOUNT_ID=${ACCOUNT_ID:-992901234567}              # unidentified wallet, max 10,000 TJS
+CONCURRENT_DEPOSITS=${CONCURRENT_DEPOSITS:-300}
+DEPOSIT_AMOUNT=${DEPOSIT_AMOUNT:-5000}               # 50 TJS
+MAX_BALANCE=${MAX_BALANCE:-1000000}                  # 10,000 TJS in dirams
+
+# Colors for output
+GREEN='\033[0;32m'
+RED='\033[0;31m'
+YELLOW='\033[1;33m'
+NC='\033[0m' # No Color
+
+# Function to compute HMAC-SHA1
+compute_hmac() {
+    local data="$1"
+    echo -n "$data" | openssl dgst -sha1 -hmac "$SECRET_KEY" | cut -d' ' -f2
+}
+
+# Function to make a signed API request and print the raw response
+api_call() {
+    local endpoint="$1"
+    local data="$2"
+    # shellcheck disable=SC2155
+    local digest=$(compute_hmac "$data")
+
+    curl -s -X POST "$API_URL$endpoint" \
+        -H "Content-Type: application/json" \
+        -H "X-UserId: $USER_ID" \
+        -H "X-Digest: $digest" \
+        -d "$data"
+}
+
+get_balance() {
+    api_call "/wallet/balance" "{\"account_id\":\"$ACCOUNT_ID\"}" | grep -o '"balance":[0-9]*' | cut -d':' -f2
+}
+
+echo "========================================="
+echo "E-Wallet Concurrency Test"
+echo "========================================="
+echo ""
+
+initial_balance=$(get_balance)
+if [ -z "$initial_balance" ]; then
+    echo -e "${RED}✗ Could not read balance of wallet $ACCOUNT_ID${NC}"
+    exit 1
+fi
+echo "Wallet:          $ACCOUNT_ID"
+echo "Initial balance: $initial_balance dirams"
+echo "Deposits:        $CONCURRENT_DEPOSITS x $DEPOSIT_AMOUNT dirams"
+echo ""
+
+results_dir=$(mktemp -d)
+trap 'rm -rf "$results_dir"' EXIT
+
+deposit_body="{\"account_id\":\"$ACCOUNT_ID\",\"amount\":$DEPOSIT_AMOUNT}"
+
+echo -e "${YELLOW}Firing $CONCURRENT_DEPOSITS parallel deposits...${NC}"
+for i in $(seq 1 "$CONCURRENT_DEPOSITS"); do
+    api_call "/wallet/deposit" "$deposit_body" > "$results_dir/$i.json" &
+done
+wait
+
+succeeded=$(grep -l '"success":true' "$results_dir"/*.json | wc -l | tr -d ' ')
+limit_rejected=$(grep -lE '(BALANCE|OPERATION|DAILY_COUNT|DAILY_AMOUNT|MONTHLY_COUNT|MONTHLY_AMOUNT)_LIMIT_EXCEEDED' "$results_dir"/*.json | wc -l | tr -d ' ')
+rate_limited=$(grep -l 'RATE_LIMIT_EXCEEDED' "$results_dir"/*.json | wc -l | tr -d ' ')
+other=$((CONCURRENT_DEPOSITS - succeeded - limit_rejected - rate_limited))
+
+echo "Succeeded:       $succeeded"
+echo "Limit rejected:  $limit_rejected"
+echo "Rate limited:    $rate_limited"
+echo "Other errors:    $other"
+echo ""
+
+final_balance=$(get_balance)
+expected_balance=$((initial_balance + succeeded * DEPOSIT_AMOUNT))
+echo "Final balance:   $final_balance dirams"
+echo "Expected:        $expected_balance dirams"
+echo ""
+
+failed=0
+
+if [ "$final_balance" -eq "$expected_balance" ]; then
+    echo -e "${GREEN}✓ No lost updates: final balance matches successful deposits${NC}"
+else
+    echo -e "${RED}✗ Lost updates: final balance differs from successful deposits${NC}"
+    failed=1
+fi
+
+if [ "$final_balance" -le "$MAX_BALANCE" ]; then
+    echo -e "${GREEN}✓ Balance limit respected${NC}"
+else
+    echo -e "${RED}✗ Balance limit exceeded: $final_balance > $MAX_BALANCE${NC}"
+    failed=1
+fi
+
+if [ "$rate_limited" -gt 0 ]; then
+    echo -e "${YELLOW}ℹ $rate_limited requests were rate limited; raise rate_limiter.requests_per_window for a full run${NC}"
+fi
+
+echo "========================================="
+exit $failed
@@ -6,7 +6,11 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // Color constants for terminal output
@@ -24,6 +28,14 @@ const (
 	endpointWalletBalance      = "/wallet/balance"
 	endpointWalletDeposit      = "/wallet/deposit"
 	endpointWalletMonthlyStats = "/wallet/monthly-stats"
+	endpointWalletWithdraw     = "/wallet/withdraw"
+	endpointWalletTransfer     = "/wallet/transfer"
+	endpointWalletTransactions = "/wallet/transactions"
+	endpointWalletCreate       = "/wallet/create"
+	endpointWalletTiers        = "/wallet/tiers"
+	endpointWalletLimits       = "/wallet/limits"
+	endpointTransactionReverse = "/transaction/reverse"
+	endpointHoldAuthorize      = "/hold/authorize"
 )
 
 // Default credentials
@@ -40,10 +52,15 @@ const (
 	secretKeyMegafonAPI       = "megafon_key_secure"
 	userIDTcellIntegration    = "tcell_integration"
 	secretKeyTcellIntegration = "tcell_hmac_key"
+	userIDAdmin               = "ewallet_admin"
+	secretKeyAdmin            = "admin_secret_2025"
 )
 
 // API base URL
-const apiBaseURL = "http://localhost:8080/api/v1"
+const (
+	apiBaseURL  = "http://localhost:8080/api/v1"
+	apiBasePath = "/api/v1"
+)
 
 func main() {
 	interactive := flag.Bool("i", false, "Interactive mode")
@@ -51,6 +68,7 @@ func main() {
 	secret := flag.String("secret", defaultSecretKey, "Secret key for HMAC")
 	userID := flag.String("user", defaultUserID, "User ID for X-UserId header")
 	endpoint := flag.String("endpoint", endpointWalletBalance, "API endpoint")
+	replay := flag.Bool("replay", false, "Sign with X-Timestamp and X-Nonce (replay-protected clients)")
 	help := flag.Bool("help", false, "Show help message")
 
 	flag.Parse()
@@ -70,7 +88,7 @@ func main() {
 		return
 	}
 
-	generateAndPrint(*body, *secret, *userID, *endpoint)
+	generateAndPrint(*body, *secret, *userID, *endpoint, *replay)
 }
 
 func runInteractive() {
@@ -86,13 +104,24 @@ func runInteractive() {
 	fmt.Println("2. Get balance")
 	fmt.Println("3. Deposit")
 	fmt.Println("4. Monthly stats")
-	fmt.Print("\nEnter choice (1-4): ")
+	fmt.Println("5. Withdraw")
+	fmt.Println("6. Transfer")
+	fmt.Println("7. Transaction history")
+	fmt.Println("8. Create wallet")
+	fmt.Println("9. Wallet tiers")
+	fmt.Println("10. Wallet limits")
+	fmt.Println("11. Reverse transaction")
+	fmt.Println("12. Authorize hold")
+	fmt.Print("\nEnter choice (1-12): ")
 
 	choice, _ := reader.ReadString('\n')
 	choice = strings.TrimSpace(choice)
 
 	var endpoint string
 	var needAmount bool
+	var isTransfer bool
+	var noParams bool
+	var isReversal bool
 
 	switch choice {
 	case "1":
@@ -104,30 +133,90 @@ func runInteractive() {
 		needAmount = true
 	case "4":
 		endpoint = endpointWalletMonthlyStats
+	case "5":
+		endpoint = endpointWalletWithdraw
+		needAmount = true
+	case "6":
+		endpoint = endpointWalletTransfer
+		needAmount = true
+		isTransfer = true
+	case "7":
+		endpoint = endpointWalletTransactions
+	case "8":
+		endpoint = endpointWalletCreate
+	case "9":
+		endpoint = endpointWalletTiers
+		noParams = true
+	case "10":
+		endpoint = endpointWalletLimits
+	case "11":
+		endpoint = endpointTransactionReverse
+		isReversal = true
+	case "12":
+		endpoint = endpointHoldAuthorize
+		needAmount = true
 	default:
 		fmt.Printf("%sInvalid choice%s\n", colorRed, colorReset)
 		return
 	}
 
-	// Enter account ID
-	fmt.Print("\nEnter account_id (e.g., 992900123456): ")
-	accountID, _ := reader.ReadString('\n')
-	accountID = strings.TrimSpace(accountID)
+	// Endpoints without parameters are signed over an empty JSON object
+	body := "{}"
+	if isReversal {
+		fmt.Print("\nEnter transaction_id (e.g., 42): ")
+		transactionID, _ := reader.ReadString('\n')
+		transactionID = strings.TrimSpace(transactionID)
 
-	if accountID == "" {
-		fmt.Printf("%sAccount ID cannot be empty%s\n", colorRed, colorReset)
-		return
-	}
+		if transactionID == "" {
+			fmt.Printf("%sTransaction ID cannot be empty%s\n", colorRed, colorReset)
+			return
+		}
 
-	// Build JSON body
-	var body string
-	if needAmount {
-		fmt.Print("Enter amount (e.g., 10000): ")
+		fmt.Print("Enter amount (empty for the full amount): ")
 		amount, _ := reader.ReadString('\n')
 		amount = strings.TrimSpace(amount)
-		body = fmt.Sprintf(`{"account_id":"%s","amount":%s}`, accountID, amount)
-	} else {
-		body = fmt.Sprintf(`{"account_id":"%s"}`, accountID)
+		if amount == "" {
+			body = fmt.Sprintf(`{"transaction_id":%s}`, transactionID)
+		} else {
+			body = fmt.Sprintf(`{"transaction_id":%s,"amount":%s}`, transactionID, amount)
+		}
+	} else if !noParams {
+		// Enter account ID
+		fmt.Print("\nEnter account_id (e.g., 992900123456): ")
+		accountID, _ := reader.ReadString('\n')
+		accountID = strings.TrimSpace(accountID)
+
+		if accountID == "" {
+			fmt.Printf("%sAccount ID cannot be empty%s\n", colorRed, colorReset)
+			return
+		}
+
+		var toAccountID string
+		if isTransfer {
+			fmt.Print("Enter destination account_id (e.g., 992935789012): ")
+			toAccountID, _ = reader.ReadString('\n')
+			toAccountID = strings.TrimSpace(toAccountID)
+
+			if toAccountID == "" {
+				fmt.Printf("%sDestination account ID cannot be empty%s\n", colorRed, colorReset)
+				return
+			}
+		}
+
+		// Build JSON body
+		if isTransfer {
+			fmt.Print("Enter amount (e.g., 10000): ")
+			amount, _ := reader.ReadString('\n')
+			amount = strings.TrimSpace(amount)
+			body = fmt.Sprintf(`{"from_account_id":"%s","to_account_id":"%s","amount":%s}`, accountID, toAccountID, amount)
+		} else if needAmount {
+			fmt.Print("Enter amount (e.g., 10000): ")
+			amount, _ := reader.ReadString('\n')
+			amount = strings.TrimSpace(amount)
+			body = fmt.Sprintf(`{"account_id":"%s","amount":%s}`, accountID, amount)
+		} else {
+			body = fmt.Sprintf(`{"account_id":"%s"}`, accountID)
+		}
 	}
 
 	// Select credentials
@@ -135,7 +224,8 @@ func runInteractive() {
 	fmt.Println("1. alif_partner / alif_secret_2025")
 	fmt.Println("2. megafon_api / megafon_key_secure")
 	fmt.Println("3. tcell_integration / tcell_hmac_key")
-	fmt.Print("\nEnter choice (1-3, default 1): ")
+	fmt.Println("4. ewallet_admin / admin_secret_2025")
+	fmt.Print("\nEnter choice (1-4, default 1): ")
 
 	credChoice, _ := reader.ReadString('\n')
 	credChoice = strings.TrimSpace(credChoice)
@@ -148,17 +238,32 @@ func runInteractive() {
 	case "3":
 		userID = userIDTcellIntegration
 		secret = secretKeyTcellIntegration
+	case "4":
+		userID = userIDAdmin
+		secret = secretKeyAdmin
 	default:
 		userID = userIDAlifPartner
 		secret = secretKeyAlifPartner
 	}
 
+	fmt.Print("\nReplay protection enabled for this client? (y/N): ")
+	replayChoice, _ := reader.ReadString('\n')
+	replay := strings.EqualFold(strings.TrimSpace(replayChoice), "y")
+
 	fmt.Println()
-	generateAndPrint(body, secret, userID, endpoint)
+	generateAndPrint(body, secret, userID, endpoint, replay)
 }
 
-func generateAndPrint(body, secret, userID, endpoint string) {
-	digest := crypto.ComputeHMAC(crypto.AlgorithmSHA1, secret, body)
+func generateAndPrint(body, secret, userID, endpoint string, replay bool) {
+	signedData := body
+	var timestamp, nonce string
+	if replay {
+		timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+		nonce = uuid.New().String()
+		signedData = crypto.BuildSigningString("POST", apiBasePath+endpoint, timestamp, nonce, body)
+	}
+
+	digest := crypto.ComputeHMAC(crypto.AlgorithmSHA1, secret, signedData)
 
 	fmt.Printf("%s==========================================%s\n", colorBlue, colorReset)
 	fmt.Printf("%sGenerated HMAC-SHA1 Signature%s\n", colorBlue, colorReset)
@@ -174,6 +279,12 @@ func generateAndPrint(body, secret, userID, endpoint string) {
 	fmt.Printf("%sHMAC-SHA1 Digest:%s\n", colorGreen, colorReset)
 	fmt.Printf("%s%s%s\n\n", colorYellow, digest, colorReset)
 
+	if replay {
+		fmt.Printf("%sReplay Protection:%s\n", colorGreen, colorReset)
+		fmt.Printf("X-Timestamp: %s\n", timestamp)
+		fmt.Printf("X-Nonce:     %s\n\n", nonce)
+	}
+
 	fmt.Printf("%s==========================================%s\n", colorBlue, colorReset)
 	fmt.Printf("%scURL Command:%s\n\n", colorGreen, colorReset)
 
@@ -181,6 +292,10 @@ func generateAndPrint(body, secret, userID, endpoint string) {
 	fmt.Printf("  -H 'Content-Type: application/json' \\\n")
 	fmt.Printf("  -H 'X-UserId: %s' \\\n", userID)
 	fmt.Printf("  -H 'X-Digest: %s' \\\n", digest)
+	if replay {
+		fmt.Printf("  -H 'X-Timestamp: %s' \\\n", timestamp)
+		fmt.Printf("  -H 'X-Nonce: %s' \\\n", nonce)
+	}
 	fmt.Printf("  -d '%s'\n\n", body)
 
 	fmt.Printf("%s==========================================%s\n", colorBlue, colorReset)
@@ -206,6 +321,8 @@ func printHelp() {
 	fmt.Println("        User ID for X-UserId header (default: alif_partner)")
 	fmt.Println("  -endpoint string")
 	fmt.Println("        API endpoint (default: /wallet/balance)")
+	fmt.Println("  -replay")
+	fmt.Println("        Sign with X-Timestamp and X-Nonce (replay-protected clients)")
 	fmt.Println("  -help")
 	fmt.Println("        Show this help message")
 	fmt.Println()
@@ -225,12 +342,47 @@ func printHelp() {
 	fmt.Println("  User ID: alif_partner,       Secret: alif_secret_2025")
 	fmt.Println("  User ID: megafon_api,        Secret: megafon_key_secure")
 	fmt.Println("  User ID: tcell_integration,  Secret: tcell_hmac_key")
+	fmt.Println("  User ID: ewallet_admin,      Secret: admin_secret_2025 (admin)")
 	fmt.Println()
 
 	fmt.Printf("%sAPI Endpoints:%s\n", colorYellow, colorReset)
 	fmt.Println("  POST /api/v1/wallet/check         - Check if wallet exists")
+	fmt.Println("  POST /api/v1/wallet/create        - Create a wallet")
 	fmt.Println("  POST /api/v1/wallet/balance       - Get wallet balance")
 	fmt.Println("  POST /api/v1/wallet/deposit       - Deposit to wallet")
-	fmt.Println("  POST /api/v1/wallet/monthly-stats - Get monthly statistics")
+	fmt.Println("  POST /api/v1/wallet/withdraw      - Withdraw from wallet")
+	fmt.Println("  POST /api/v1/wallet/transfer      - Transfer between wallets")
+	fmt.Println("  POST /api/v1/wallet/identify      - Submit KYC data for identification")
+	fmt.Println("  POST /api/v1/wallet/monthly-stats - Get statistics for a month or date range")
+	fmt.Println("  POST /api/v1/wallet/transactions  - Get transaction history")
+	fmt.Println("  POST /api/v1/wallet/tiers         - List wallet tiers and their limits")
+	fmt.Println("  POST /api/v1/wallet/limits        - Get remaining balance and turnover limits")
+	fmt.Println("  POST /api/v1/transaction/reverse  - Reverse a deposit or withdrawal")
+	fmt.Println("  POST /api/v1/transaction/get      - Get a transaction by ID or external ID")
+	fmt.Println("  POST /api/v1/transaction/status   - Get the status of a transaction")
+	fmt.Println("  POST /api/v1/hold/authorize       - Reserve money on a wallet")
+	fmt.Println("  POST /api/v1/hold/capture         - Capture all or part of a hold")
+	fmt.Println("  POST /api/v1/hold/void            - Release a hold")
+	fmt.Println("  POST /api/v1/webhook/subscribe    - Subscribe an endpoint to wallet events")
+	fmt.Println("  POST /api/v1/webhook/unsubscribe  - Remove a webhook subscription")
+	fmt.Println("  POST /api/v1/webhook/subscriptions - List webhook subscriptions")
+	fmt.Println("  POST /api/v1/webhook/deliveries   - List webhook deliveries")
+	fmt.Println("  POST /api/v1/webhook/redeliver    - Queue a webhook delivery again")
+	fmt.Println("  POST /api/v1/float/statement      - Get float balance and movements")
+	fmt.Println("  POST /api/v1/settlement/report    - Get daily settlements")
+	fmt.Println("  POST /api/v1/settlement/report/csv - Download daily settlements as CSV")
+	fmt.Println()
+	fmt.Printf("%sAdmin Endpoints:%s\n", colorYellow, colorReset)
+	fmt.Println("  POST /api/v1/admin/wallet/identification/approve - Approve a pending identification")
+	fmt.Println("  POST /api/v1/admin/wallet/identification/reject  - Reject a pending identification")
+	fmt.Println("  POST /api/v1/admin/wallet/identification/history - Identification audit trail")
+	fmt.Println("  POST /api/v1/admin/wallet/status                 - Change wallet status")
+	fmt.Println("  POST /api/v1/admin/wallet/status/history         - Wallet status audit trail")
+	fmt.Println("  POST /api/v1/admin/ledger/trial-balance          - Ledger trial balance")
+	fmt.Println("  POST /api/v1/admin/reconciliation/run            - Reconcile wallet balances")
+	fmt.Println("  POST /api/v1/admin/float/top-up                  - Top up a partner float")
+	fmt.Println("  POST /api/v1/admin/fees/schedule                 - Add a fee schedule version")
+	fmt.Println("  POST /api/v1/admin/fees/schedules                - List fee schedule versions")
+	fmt.Println("  POST /api/v1/admin/settlement/run                - Settle an ended business day")
 	fmt.Println()
 }